@@ -0,0 +1,10 @@
+data "okta_campaign_report" "csv" {
+  campaign_id = "icirpt8vuFO2JzF1d7a1"
+  output_path = "replace_with_output_dir/report.csv"
+}
+
+data "okta_campaign_report" "json" {
+  campaign_id   = "icirpt8vuFO2JzF1d7a1"
+  output_path   = "replace_with_output_dir/report.out"
+  output_format = "json"
+}
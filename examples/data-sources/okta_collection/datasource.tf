@@ -0,0 +1,12 @@
+resource "okta_collection" "test" {
+  name        = "collection data source test"
+  description = "testing collection data source"
+}
+
+data "okta_collection" "by_id" {
+  id = okta_collection.test.id
+}
+
+data "okta_collection" "by_name" {
+  name = okta_collection.test.name
+}
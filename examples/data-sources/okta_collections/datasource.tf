@@ -0,0 +1,9 @@
+resource "okta_collection" "test" {
+  name = "collections data source test"
+}
+
+data "okta_collections" "test" {
+  filter = "name sw \"collections data source\""
+
+  depends_on = [okta_collection.test]
+}
@@ -0,0 +1,14 @@
+resource "okta_email_smtp_server" "test" {
+  port     = 587
+  host     = "smtp.example.com"
+  username = "abcd"
+  password = "abcd"
+  alias    = "server5"
+}
+
+data "okta_email_smtp_server_test_result" "test" {
+  server_id       = okta_email_smtp_server.test.id
+  from            = "noreply@example.com"
+  to              = "admin@example.com"
+  send_test_email = true
+}
@@ -0,0 +1,11 @@
+resource "okta_governance_delegate" "test" {
+  principal_id = "00unkw1sfbTw08c0g1d7"
+  delegate_id  = "00uo0ntv2fYJkUwLO1d7"
+  end_time     = "2025-10-31T00:00:00Z"
+}
+
+data "okta_governance_delegates" "test" {
+  delegate_id = okta_governance_delegate.test.delegate_id
+
+  depends_on = [okta_governance_delegate.test]
+}
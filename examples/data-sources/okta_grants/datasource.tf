@@ -0,0 +1,21 @@
+resource "okta_grant" "test" {
+  principal_id = "00unkw1sfbTw08c0g1d7"
+  resource_orn = "orn:oktapreview:idp:00onkw1sbuAh3Q06I1d7:apps:oidc_client:0oao01ardu8r8qUP91d7"
+
+  entitlements {
+    id = "espzcbqd7Suwp4Y7A1d6"
+
+    values {
+      id = "entzcbqd8lcD3BRWR1d6"
+    }
+  }
+}
+
+data "okta_grants" "by_principal" {
+  principal_id = okta_grant.test.principal_id
+}
+
+data "okta_grants" "by_resource" {
+  principal_id = okta_grant.test.principal_id
+  resource_orn = okta_grant.test.resource_orn
+}
@@ -0,0 +1,31 @@
+resource "okta_inline_hook" "test" {
+  name    = "testAcc_replace_with_uuid"
+  type    = "com.okta.oauth2.tokens.transform"
+  version = "1.0.0"
+  status  = "ACTIVE"
+
+  channel = {
+    version = "1.0.0"
+    uri     = "https://example.com/test"
+    method  = "POST"
+  }
+}
+
+data "okta_inline_hook_preview" "test" {
+  inline_hook_id = okta_inline_hook.test.id
+  payload_json = jsonencode({
+    eventType = "com.okta.oauth2.tokens.transform"
+    data = {
+      context = {
+        protocol = {
+          type = "OAUTH2.0"
+        }
+      }
+      identity = {
+        claims = {
+          sub = "00u1example"
+        }
+      }
+    }
+  })
+}
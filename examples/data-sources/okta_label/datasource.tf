@@ -0,0 +1,18 @@
+resource "okta_label" "test" {
+  name = "label data source test"
+
+  values {
+    name             = "PCI"
+    background_color = "yellow"
+  }
+}
+
+data "okta_label" "by_id" {
+  id = okta_label.test.id
+}
+
+data "okta_label" "by_name" {
+  name = "label data source test"
+
+  depends_on = [okta_label.test]
+}
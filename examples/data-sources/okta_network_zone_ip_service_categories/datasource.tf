@@ -0,0 +1 @@
+data "okta_network_zone_ip_service_categories" "test" {}
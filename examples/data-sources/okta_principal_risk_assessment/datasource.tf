@@ -0,0 +1,4 @@
+data "okta_principal_risk_assessment" "test" {
+  user_id      = "00unkw1sfbTw08c0g1d7"
+  resource_orn = "orn:oktapreview:idp:00onkw1sbuAh3Q06I1d7:apps:oidc_client:0oao01ardu8r8qUP91d7"
+}
@@ -0,0 +1,4 @@
+data "okta_request_sequence" "test" {
+  resource_id = "0oaoum6j3cElINe1z1d7"
+  name        = "Business Justification + Requester's Manager Approval/Justification"
+}
@@ -0,0 +1,13 @@
+resource "okta_app_oauth" "test" {
+  label                      = "testAcc_replace_with_uuid"
+  type                       = "service"
+  grant_types                = ["client_credentials"]
+  response_types             = ["token"]
+  token_endpoint_auth_method = "client_secret_basic"
+  skip_client_secret         = true
+}
+
+resource "okta_app_oauth_client_secret" "test" {
+  app_id = okta_app_oauth.test.id
+  status = "INACTIVE"
+}
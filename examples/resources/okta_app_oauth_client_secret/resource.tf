@@ -0,0 +1,23 @@
+resource "okta_app_oauth" "example" {
+  label                      = "example"
+  type                       = "service"
+  grant_types                = ["client_credentials"]
+  response_types             = ["token"]
+  token_endpoint_auth_method = "client_secret_basic"
+  skip_client_secret         = true
+}
+
+# Bump rotation to replace the secret. The new secret is created before the
+# old one is deactivated and deleted, so the app always has an active secret.
+resource "terraform_data" "rotation" {
+  input = "2026-10"
+}
+
+resource "okta_app_oauth_client_secret" "example" {
+  app_id = okta_app_oauth.example.id
+
+  lifecycle {
+    create_before_destroy = true
+    replace_triggered_by  = [terraform_data.rotation]
+  }
+}
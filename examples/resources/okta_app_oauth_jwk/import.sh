@@ -0,0 +1 @@
+terraform import okta_app_oauth_jwk.example <app_id>/<key_id>
@@ -0,0 +1,26 @@
+resource "okta_app_oauth" "example" {
+  label                      = "example"
+  type                       = "service"
+  response_types             = ["token"]
+  grant_types                = ["client_credentials"]
+  token_endpoint_auth_method = "private_key_jwt"
+  ignore_unmanaged_jwks      = true
+
+  # A bootstrap key is required to create a private_key_jwt app
+  jwks {
+    kty = "EC"
+    kid = "bootstrap"
+    x   = "K37X78mXJHHldZYMzrwipjKR-YZUS2SMye0KindHp6I"
+    y   = "8IfvsvXWzbFWOZoVOMwgF5p46mUj3kbOVf9Fk0vVVHo"
+  }
+}
+
+# The kid is derived from the RFC 7638 thumbprint of the key when omitted
+resource "okta_app_oauth_jwk" "example" {
+  app_id         = okta_app_oauth.example.id
+  public_key_pem = file("${path.module}/public.pem")
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
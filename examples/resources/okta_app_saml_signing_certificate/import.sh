@@ -0,0 +1 @@
+terraform import okta_app_saml_signing_certificate.example <app_id>/<key_id>
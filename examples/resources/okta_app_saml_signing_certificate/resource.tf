@@ -0,0 +1,42 @@
+resource "okta_app_saml" "example" {
+  label                    = "example"
+  sso_url                  = "https://example.com"
+  recipient                = "https://example.com"
+  destination              = "https://example.com"
+  audience                 = "https://example.com/audience"
+  subject_name_id_template = "$${user.userName}"
+  subject_name_id_format   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  response_signed          = true
+  signature_algorithm      = "RSA_SHA256"
+  digest_algorithm         = "SHA256"
+  honor_force_authn        = false
+  authn_context_class_ref  = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
+}
+
+resource "okta_app_saml_signing_csr" "example" {
+  app_id = okta_app_saml.example.id
+
+  subject {
+    common_name       = "example.com"
+    organization_name = "Example"
+  }
+}
+
+# Any CA works, here the CSR is signed with a CA managed by the tls provider
+resource "tls_locally_signed_cert" "example" {
+  cert_request_pem   = okta_app_saml_signing_csr.example.csr_pem
+  ca_private_key_pem = var.ca_private_key_pem
+  ca_cert_pem        = var.ca_cert_pem
+
+  validity_period_hours = 8760
+  allowed_uses          = ["digital_signature"]
+}
+
+# The key becomes the signing key of the app when key_id of okta_app_saml is
+# set to okta_app_saml_signing_certificate.example.key_id, in a configuration
+# that does not manage the CSR of the app.
+resource "okta_app_saml_signing_certificate" "example" {
+  app_id          = okta_app_saml.example.id
+  csr_id          = okta_app_saml_signing_csr.example.id
+  certificate_pem = tls_locally_signed_cert.example.cert_pem
+}
@@ -0,0 +1 @@
+terraform import okta_app_saml_signing_csr.example <app_id>/<csr_id>
@@ -0,0 +1,27 @@
+resource "okta_app_saml" "example" {
+  label                    = "example"
+  sso_url                  = "https://example.com"
+  recipient                = "https://example.com"
+  destination              = "https://example.com"
+  audience                 = "https://example.com/audience"
+  subject_name_id_template = "$${user.userName}"
+  subject_name_id_format   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  response_signed          = true
+  signature_algorithm      = "RSA_SHA256"
+  digest_algorithm         = "SHA256"
+  honor_force_authn        = false
+  authn_context_class_ref  = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
+}
+
+resource "okta_app_saml_signing_csr" "example" {
+  app_id = okta_app_saml.example.id
+
+  subject {
+    common_name              = "example.com"
+    country_name             = "US"
+    state_or_province_name   = "California"
+    locality_name            = "San Francisco"
+    organization_name        = "Example"
+    organizational_unit_name = "IT"
+  }
+}
@@ -0,0 +1 @@
+terraform import okta_auth_server_key_rotation.example <auth_server_id>
@@ -0,0 +1,12 @@
+resource "okta_auth_server" "example" {
+  audiences                 = ["api://example"]
+  credentials_rotation_mode = "MANUAL"
+  name                      = "example"
+}
+
+# Rotate the signing keys every 90 days
+resource "okta_auth_server_key_rotation" "example" {
+  auth_server_id = okta_auth_server.example.id
+  rotation_days  = 90
+  wait_for_jwks  = true
+}
@@ -0,0 +1,53 @@
+resource "okta_campaign" "test" {
+  name           = "Campaign lifecycle test"
+  description    = "Multi app campaign"
+  campaign_type  = "RESOURCE"
+  desired_status = "READY"
+
+  schedule_settings {
+    type             = "ONE_OFF"
+    start_date       = "2026-10-04T13:43:40.000Z"
+    duration_in_days = 21
+    time_zone        = "America/Vancouver"
+  }
+
+  resource_settings {
+    type                                    = "APPLICATION"
+    include_entitlements                    = false
+    individually_assigned_apps_only         = false
+    individually_assigned_groups_only       = false
+    only_include_out_of_policy_entitlements = false
+    target_resources {
+      resource_id                          = "0oaws4am895IZbn6Q1d7"
+      resource_type                        = "APPLICATION"
+      include_all_entitlements_and_bundles = false
+    }
+  }
+
+  principal_scope_settings {
+    type                      = "USERS"
+    include_only_active_users = false
+  }
+
+  reviewer_settings {
+    type                   = "USER"
+    reviewer_id            = "00uwvhe0y85W3Iq6x1d7"
+    self_review_disabled   = true
+    justification_required = true
+    bulk_decision_disabled = true
+  }
+
+  notification_settings {
+    notify_reviewer_when_review_assigned      = false
+    notify_reviewer_at_campaign_end           = false
+    notify_reviewer_when_overdue              = false
+    notify_reviewer_during_midpoint_of_review = false
+    notify_review_period_end                  = false
+  }
+
+  remediation_settings {
+    access_approved = "NO_ACTION"
+    access_revoked  = "NO_ACTION"
+    no_response     = "NO_ACTION"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "okta_collection" "test" {
+  name        = "test-collection"
+  description = "testing collection"
+}
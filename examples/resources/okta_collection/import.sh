@@ -0,0 +1 @@
+terraform import okta_collection.example <collection_id>
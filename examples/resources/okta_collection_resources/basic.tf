@@ -0,0 +1,11 @@
+resource "okta_collection" "test" {
+  name = "test-collection-resources"
+}
+
+resource "okta_collection_resources" "test" {
+  collection_id = okta_collection.test.id
+
+  resource {
+    resource_orn = "orn:oktapreview:idp:00onkw1sbuAh3Q06I1d7:apps:oidc_client:0oao01ardu8r8qUP91d7"
+  }
+}
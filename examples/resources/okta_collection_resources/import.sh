@@ -0,0 +1 @@
+terraform import okta_collection_resources.example <collection_id>
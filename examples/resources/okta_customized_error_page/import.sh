@@ -0,0 +1 @@
+terraform import okta_customized_error_page.example <brand_id>
@@ -0,0 +1,11 @@
+data "okta_brands" "test" {
+}
+
+resource "okta_email_customization" "forgot_password_en" {
+  brand_id             = tolist(data.okta_brands.test.brands)[0].id
+  template_name        = "ForgotPassword"
+  language             = "en"
+  is_default           = true
+  from_default_content = true
+  subject              = "Reset your $${org.name} password"
+}
@@ -0,0 +1,11 @@
+data "okta_brands" "test" {
+}
+
+resource "okta_email_customization" "forgot_password_en" {
+  brand_id      = tolist(data.okta_brands.test.brands)[0].id
+  template_name = "ForgotPassword"
+  language      = "en"
+  is_default    = true
+  subject       = "Account password reset for $${org.name}"
+  body          = "Hi $${user.profile.firstName},<br/><br/>Click this link to reset your password: $${resetPaswordLink}"
+}
@@ -0,0 +1,19 @@
+data "okta_brands" "test" {
+}
+
+resource "okta_email_customizations" "test" {
+  brand_id             = tolist(data.okta_brands.test.brands)[0].id
+  template_name        = "ForgotPassword"
+  default_language     = "en"
+  from_default_content = true
+  recipients           = "ALL_USERS"
+
+  customization {
+    language = "en"
+    subject  = "Reset your $${org.name} password"
+  }
+
+  customization {
+    language = "es"
+  }
+}
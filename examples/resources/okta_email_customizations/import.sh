@@ -0,0 +1 @@
+terraform import okta_email_customizations.example "<brand_id>/<template_name>"
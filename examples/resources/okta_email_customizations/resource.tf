@@ -0,0 +1,16 @@
+resource "okta_email_customizations" "forgot_password" {
+  brand_id             = "<brand id>"
+  template_name        = "ForgotPassword"
+  default_language     = "en"
+  from_default_content = true
+  recipients           = "ALL_USERS"
+
+  customization {
+    language = "en"
+    subject  = "Reset your $${org.name} password"
+  }
+
+  customization {
+    language = "fr"
+  }
+}
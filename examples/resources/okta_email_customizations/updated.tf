@@ -0,0 +1,20 @@
+data "okta_brands" "test" {
+}
+
+resource "okta_email_customizations" "test" {
+  brand_id             = tolist(data.okta_brands.test.brands)[0].id
+  template_name        = "ForgotPassword"
+  default_language     = "fr"
+  from_default_content = true
+  recipients           = "ADMINS_ONLY"
+
+  customization {
+    language = "en"
+    subject  = "Reset your $${org.name} password"
+    body     = "Hi $${user.profile.firstName},<br/><br/>Click this link to reset your password: $${resetPasswordLink}"
+  }
+
+  customization {
+    language = "fr"
+  }
+}
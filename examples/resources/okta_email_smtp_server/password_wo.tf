@@ -0,0 +1,9 @@
+resource "okta_email_smtp_server" "test" {
+  alias               = "CustomisedServer"
+  host                = "192.168.2.0"
+  port                = 8086
+  username            = "test_user"
+  enabled             = false
+  password_wo         = "testPwd"
+  password_wo_version = 1
+}
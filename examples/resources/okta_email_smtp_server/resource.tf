@@ -1,8 +1,14 @@
 resource "okta_email_smtp_server" "test" {
-  alias    = "CustomisedServer"
-  host     = "192.168.2.0"
-  port     = 8086
-  username = "test_user"
-  enabled  = false
-  password = "testPwd"
+  alias               = "CustomisedServer"
+  host                = "smtp.example.com"
+  port                = 587
+  username            = "test_user"
+  enabled             = false
+  password_wo         = var.smtp_password
+  password_wo_version = 1
+
+  verify_on_apply {
+    from = "noreply@example.com"
+    to   = "admin@example.com"
+  }
 }
@@ -0,0 +1,10 @@
+resource "okta_email_template_test_send" "example" {
+  brand_id         = okta_email_customization.example.brand_id
+  template_name    = okta_email_customization.example.template_name
+  customization_id = okta_email_customization.example.id
+
+  triggers = {
+    subject = okta_email_customization.example.subject
+    body    = okta_email_customization.example.body
+  }
+}
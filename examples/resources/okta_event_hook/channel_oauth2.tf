@@ -0,0 +1,18 @@
+resource "okta_event_hook" "test" {
+  name   = "testAcc_replace_with_uuid"
+  events = ["user.lifecycle.create"]
+
+  channel = {
+    version = "1.0.0"
+    uri     = "https://example.com/test"
+  }
+
+  channel_oauth2 {
+    auth_type                = "client_secret_post"
+    client_id                = "hook-client"
+    client_secret_wo         = "hook-client-secret"
+    client_secret_wo_version = 1
+    token_url                = "https://example.com/oauth2/token"
+    scope                    = "hooks.write"
+  }
+}
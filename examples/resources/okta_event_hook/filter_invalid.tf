@@ -0,0 +1,23 @@
+resource "okta_event_hook" "test" {
+  name = "testAcc_replace_with_uuid"
+  events = [
+    "user.lifecycle.create",
+  ]
+
+  filter {
+    event     = "group.user_membership.add"
+    condition = "event.target.?[type eq 'UserGroup'].size()>0"
+  }
+
+  channel = {
+    type    = "HTTP"
+    version = "1.0.0"
+    uri     = "https://example.com/test"
+  }
+
+  auth = {
+    type  = "HEADER"
+    key   = "Authorization"
+    value = "123"
+  }
+}
@@ -0,0 +1,7 @@
+resource "okta_governance_delegate" "test" {
+  principal_id = "00unkw1sfbTw08c0g1d7"
+  delegate_id  = "00uo0ntv2fYJkUwLO1d7"
+  start_time   = "2025-10-20T00:00:00Z"
+  end_time     = "2025-10-31T00:00:00Z"
+  note         = "Out of office"
+}
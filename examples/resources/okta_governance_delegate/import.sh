@@ -0,0 +1 @@
+terraform import okta_governance_delegate.example <principal_id>
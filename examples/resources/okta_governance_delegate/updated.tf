@@ -0,0 +1,6 @@
+resource "okta_governance_delegate" "test" {
+  principal_id = "00unkw1sfbTw08c0g1d7"
+  delegate_id  = "00uo0ntv9pQzGmXbK1d7"
+  end_time     = "2025-11-07T00:00:00Z"
+  note         = "Parental leave"
+}
@@ -0,0 +1,13 @@
+resource "okta_grant" "test" {
+  principal_id         = "00unkw1sfbTw08c0g1d7"
+  resource_orn         = "orn:oktapreview:idp:00onkw1sbuAh3Q06I1d7:apps:oidc_client:0oao01ardu8r8qUP91d7"
+  scheduled_revocation = "2026-12-31T23:59:59Z"
+
+  entitlements {
+    id = "espzcbqd7Suwp4Y7A1d6"
+
+    values {
+      id = "entzcbqd8lcD3BRWR1d6"
+    }
+  }
+}
@@ -0,0 +1,22 @@
+resource "okta_entitlement_bundle" "test" {
+  name        = "grant test bundle"
+  description = "entitlement bundle granted by okta_grant"
+
+  target {
+    external_id = "0oao01ardu8r8qUP91d7"
+    type        = "APPLICATION"
+  }
+
+  entitlements {
+    id = "espzcbqd7Suwp4Y7A1d6"
+
+    values {
+      id = "entzcbqd8lcD3BRWR1d6"
+    }
+  }
+}
+
+resource "okta_grant" "test" {
+  principal_id          = "00unkw1sfbTw08c0g1d7"
+  entitlement_bundle_id = okta_entitlement_bundle.test.id
+}
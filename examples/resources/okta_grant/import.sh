@@ -0,0 +1 @@
+terraform import okta_grant.example <grant_id>
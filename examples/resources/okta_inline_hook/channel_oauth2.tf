@@ -0,0 +1,23 @@
+resource "okta_hook_key" "test" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_inline_hook" "test" {
+  name    = "testAcc_replace_with_uuid"
+  type    = "com.okta.oauth2.tokens.transform"
+  version = "1.0.0"
+
+  channel = {
+    version = "1.0.0"
+    uri     = "https://example.com/test"
+    method  = "POST"
+  }
+
+  channel_oauth2 {
+    auth_type   = "private_key_jwt"
+    client_id   = "hook-client"
+    token_url   = "https://example.com/oauth2/token"
+    scope       = "hooks.write"
+    hook_key_id = okta_hook_key.test.id
+  }
+}
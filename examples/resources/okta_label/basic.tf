@@ -0,0 +1,12 @@
+resource "okta_label" "test" {
+  name = "Compliance"
+
+  values {
+    name             = "SOX"
+    background_color = "blue"
+  }
+
+  values {
+    name = "PCI"
+  }
+}
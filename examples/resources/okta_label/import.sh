@@ -0,0 +1 @@
+terraform import okta_label.example <label_id>
@@ -0,0 +1,17 @@
+resource "okta_label" "test" {
+  name = "Compliance updated"
+
+  values {
+    name             = "SOX"
+    background_color = "blue"
+  }
+
+  values {
+    name             = "PCI"
+    background_color = "yellow"
+  }
+
+  values {
+    name = "GDPR"
+  }
+}
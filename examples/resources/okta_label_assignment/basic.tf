@@ -0,0 +1,12 @@
+resource "okta_label" "test" {
+  name = "Label assignment test"
+
+  values {
+    name = "SOX"
+  }
+}
+
+resource "okta_label_assignment" "test" {
+  label_value_id = okta_label.test.values[0].id
+  resource_orns  = ["orn:oktapreview:idp:00onkw1sbuAh3Q06I1d7:apps:oidc_client:0oao01ardu8r8qUP91d7"]
+}
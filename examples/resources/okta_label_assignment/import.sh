@@ -0,0 +1 @@
+terraform import okta_label_assignment.example <label_value_id>
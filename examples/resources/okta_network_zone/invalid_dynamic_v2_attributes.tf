@@ -0,0 +1,6 @@
+resource "okta_network_zone" "dynamic_network_zone_example" {
+  name                          = "testAcc_replace_with_uuid Dynamic"
+  type                          = "DYNAMIC"
+  dynamic_locations             = ["US"]
+  ip_service_categories_include = ["ALL_IP_SERVICES"]
+}
@@ -0,0 +1,12 @@
+resource "okta_network_zone_set" "test" {
+  name_prefix           = "testAcc_replace_with_uuid"
+  max_gateways_per_zone = 2
+  gateways = [
+    "1.2.3.0/25",
+    "1.2.3.128/25",
+    "2.3.4.5-2.3.4.15",
+    "2.3.4.10",
+    "3.3.3.3",
+    "4.4.4.0/24",
+  ]
+}
@@ -0,0 +1,9 @@
+resource "okta_network_zone_set" "test" {
+  name_prefix           = "testAcc_replace_with_uuid"
+  max_gateways_per_zone = 2
+  status                = "INACTIVE"
+  gateways = [
+    "1.2.3.0/24",
+    "2.3.4.5-2.3.4.15",
+  ]
+}
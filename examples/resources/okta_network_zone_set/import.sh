@@ -0,0 +1 @@
+terraform import okta_network_zone_set.example "<name_prefix>"
@@ -0,0 +1,12 @@
+resource "okta_network_zone_set" "example" {
+  name_prefix   = "Partner Egress"
+  gateways      = ["1.2.3.4/24", "2.3.4.5-2.3.4.15"]
+  gateways_file = "${path.module}/gateways.txt"
+}
+
+resource "okta_policy_rule_signon" "example" {
+  policy_id          = okta_policy_signon.example.id
+  name               = "Partner Egress"
+  network_connection = "ZONE"
+  network_includes   = okta_network_zone_set.example.zone_ids
+}
@@ -0,0 +1 @@
+terraform import okta_preview_error_page.example <brand_id>
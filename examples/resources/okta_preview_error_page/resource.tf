@@ -0,0 +1,14 @@
+resource "okta_brand" "test" {
+  name   = "testBrand"
+  locale = "en"
+}
+
+resource "okta_preview_error_page" "test" {
+  brand_id     = resource.okta_brand.test.id
+  page_content = "<!DOCTYPE html>\n<html>\n<head>\n    <title>{{orgName}} - {{errorSummary}}</title>\n    <meta http-equiv=\"Content-Type\" content=\"text/html; charset=UTF-8\">\n    <meta name=\"viewport\" content=\"width=device-width, initial-scale=1.0\" />\n    <meta name=\"robots\" content=\"noindex,nofollow\" />\n    <link href=\"{{themedStylesUrl}}\" rel=\"stylesheet\" type=\"text/css\">\n    <link rel=\"shortcut icon\" href=\"{{faviconUrl}}\" type=\"image/x-icon\"/>\n</head>\n<body>\n    <div class=\"content\">\n        <h2 class=\"o-form-title\">{{errorSummary}}</h2>\n        <p class=\"o-form-explain\">{{{errorDescription}}}</p>\n        <a href=\"{{back}}\" class=\"button\">{{buttonText}}</a>\n    </div>\n</body>\n</html>\n"
+  content_security_policy_setting {
+    mode       = "enforced"
+    report_uri = "https://example.com/csp-report"
+    src_list   = ["https://cdn.example.com"]
+  }
+}
@@ -0,0 +1,26 @@
+resource "okta_realm" "test" {
+  name       = "TestAcc Realm replace_with_uuid"
+  realm_type = "DEFAULT"
+}
+
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Realm"
+  login      = "testAcc-replace_with_uuid@acctest.com"
+  email      = "testAcc-replace_with_uuid@acctest.com"
+  department = "replace_with_uuid"
+}
+
+resource "okta_realm_user_migration" "test" {
+  realm_id               = okta_realm.test.id
+  search                 = "profile.department eq \"${okta_user.test.department}\""
+  batch_size             = 10
+  batch_interval_seconds = 0
+}
+
+data "okta_users" "test" {
+  search {
+    expression = "realmId eq \"${okta_realm.test.id}\""
+  }
+  depends_on = [okta_realm_user_migration.test]
+}
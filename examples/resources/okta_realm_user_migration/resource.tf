@@ -0,0 +1,11 @@
+data "okta_realm_assignment_preview" "sales" {
+  search         = "profile.department eq \"Sales\""
+  assignment_ids = [okta_realm_assignment.sales.id]
+  changed_only   = true
+}
+
+resource "okta_realm_user_migration" "sales" {
+  realm_id   = okta_realm.sales.id
+  search     = "profile.department eq \"Sales\""
+  batch_size = 50
+}
@@ -0,0 +1,11 @@
+resource "okta_request_condition" "test" {
+  resource_id          = "0oasp3g29b1hqkcYE1d7"
+  approval_sequence_id = "68cbc2b263c689fc3336bfac"
+  name                 = "test-condition-invalid-sequence"
+  access_scope_settings {
+    type = "RESOURCE_DEFAULT"
+  }
+  requester_settings {
+    type = "EVERYONE"
+  }
+}
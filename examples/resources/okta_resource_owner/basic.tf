@@ -0,0 +1,4 @@
+resource "okta_resource_owner" "test" {
+  resource_orn   = "orn:oktapreview:idp:00onkw1sbuAh3Q06I1d7:apps:oidc_client:0oao01ardu8r8qUP91d7"
+  principal_orns = ["orn:oktapreview:directory:00onkw1sbuAh3Q06I1d7:users:00unkw1sfbTw08c0g1d7"]
+}
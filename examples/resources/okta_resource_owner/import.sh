@@ -0,0 +1 @@
+terraform import okta_resource_owner.example <resource_orn>
@@ -0,0 +1,53 @@
+resource "okta_entitlement" "test" {
+  name           = "risk rule entitlement"
+  external_value = "risk_rule_entitlement"
+  multi_value    = true
+  data_type      = "array"
+
+  parent {
+    external_id = "0oao01ardu8r8qUP91d7"
+    type        = "APPLICATION"
+  }
+
+  values {
+    name           = "approve payment"
+    external_value = "approve_payment"
+  }
+
+  values {
+    name           = "process payment"
+    external_value = "process_payment"
+  }
+}
+
+resource "okta_risk_rule" "test" {
+  name         = "Process and approve payment"
+  description  = "Process and approve payment"
+  resource_orn = "orn:oktapreview:idp:00onkw1sbuAh3Q06I1d7:apps:oidc_client:0oao01ardu8r8qUP91d7"
+
+  conflict_criteria {
+    name      = "list1"
+    operation = "CONTAINS_ONE"
+
+    entitlements {
+      id = okta_entitlement.test.id
+
+      values {
+        id = okta_entitlement.test.values[0].id
+      }
+    }
+  }
+
+  conflict_criteria {
+    name      = "list2"
+    operation = "CONTAINS_ONE"
+
+    entitlements {
+      id = okta_entitlement.test.id
+
+      values {
+        id = okta_entitlement.test.values[1].id
+      }
+    }
+  }
+}
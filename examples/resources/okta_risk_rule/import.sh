@@ -0,0 +1 @@
+terraform import okta_risk_rule.example <risk_rule_id>
@@ -273,6 +273,7 @@ func (c *Config) VerifyCredentials(ctx context.Context) error {
 type TimeOperations interface {
 	DoNotRetry(error) bool
 	Sleep(time.Duration)
+	Now() time.Time
 }
 
 type ProductionTimeOperations struct{}
@@ -288,6 +289,11 @@ func (o *ProductionTimeOperations) Sleep(d time.Duration) {
 	time.Sleep(d)
 }
 
+// Now facade to actual time.Now in production
+func (o *ProductionTimeOperations) Now() time.Time {
+	return time.Now()
+}
+
 // NewProductionTimeOperations new production time operations
 func NewProductionTimeOperations() TimeOperations {
 	return &ProductionTimeOperations{}
@@ -308,6 +314,16 @@ func (o *TestTimeOperations) Sleep(d time.Duration) {
 	}
 }
 
+// Now fixed time when test is in VCR play mode so that requests built from the
+// current time match the VCR cassettes, the actual time otherwise as a live
+// org rejects dates in the past
+func (o *TestTimeOperations) Now() time.Time {
+	if os.Getenv("OKTA_VCR_TF_ACC") == "play" {
+		return time.Date(2025, time.October, 20, 10, 15, 0, 0, time.UTC)
+	}
+	return time.Now()
+}
+
 // NewTestTimeOperations new test time operations
 func NewTestTimeOperations() TimeOperations {
 	return &TestTimeOperations{}
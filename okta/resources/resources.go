@@ -19,11 +19,15 @@ const (
 	OktaIDaaSAppMetadataSaml                          = "okta_app_metadata_saml"
 	OktaIDaaSAppOAuth                                 = "okta_app_oauth"
 	OktaIDaaSAppOAuthAPIScope                         = "okta_app_oauth_api_scope"
+	OktaIDaaSAppOAuthClientSecret                     = "okta_app_oauth_client_secret"
+	OktaIDaaSAppOAuthJwk                              = "okta_app_oauth_jwk"
 	OktaIDaaSAppOAuthPostLogoutRedirectURI            = "okta_app_oauth_post_logout_redirect_uri"
 	OktaIDaaSAppOAuthRedirectURI                      = "okta_app_oauth_redirect_uri"
 	OktaIDaaSAppOAuthRoleAssignment                   = "okta_app_oauth_role_assignment"
 	OktaIDaaSAppSaml                                  = "okta_app_saml"
 	OktaIDaaSAppSamlAppSettings                       = "okta_app_saml_app_settings"
+	OktaIDaaSAppSamlSigningCertificate                = "okta_app_saml_signing_certificate"
+	OktaIDaaSAppSamlSigningCsr                        = "okta_app_saml_signing_csr"
 	OktaIDaaSAppSecurePasswordStore                   = "okta_app_secure_password_store"
 	OktaIDaaSAppSharedCredentials                     = "okta_app_shared_credentials"
 	OktaIDaaSAppSignOnPolicy                          = "okta_app_signon_policy"
@@ -43,6 +47,7 @@ const (
 	OktaIDaaSAuthServerClaims                         = "okta_auth_server_claims"
 	OktaIDaaSAuthServerClients                        = "okta_auth_server_clients"
 	OktaIDaaSAuthServerKeys                           = "okta_auth_server_keys"
+	OktaIDaaSAuthServerKeyRotation                    = "okta_auth_server_key_rotation"
 	OktaIDaaSAuthServerDefault                        = "okta_auth_server_default"
 	OktaIDaaSAuthServerPolicy                         = "okta_auth_server_policy"
 	OktaIDaaSAuthServerPolicyRule                     = "okta_auth_server_policy_rule"
@@ -54,6 +59,8 @@ const (
 	OktaIDaaSBrands                                   = "okta_brands"
 	OktaIDaaSCaptcha                                  = "okta_captcha"
 	OktaIDaaSCaptchaOrgWideSettings                   = "okta_captcha_org_wide_settings"
+	OktaIDaaSCustomizedErrorPage                      = "okta_customized_error_page"
+	OktaIDaaSDefaultErrorPage                         = "okta_default_error_page"
 	OktaIDaaSDefaultPolicy                            = "okta_default_policy"
 	OktaIDaaSDomain                                   = "okta_domain"
 	OktaIDaaSDomainCertificate                        = "okta_domain_certificate"
@@ -64,9 +71,12 @@ const (
 	OktaIDaaSEmailSenderVerification                  = "okta_email_sender_verification"
 	OktaIDaaSEmailCustomization                       = "okta_email_customization"
 	OktaIDaaSEmailCustomizations                      = "okta_email_customizations"
+	OktaIDaaSEmailCustomizationPreview                = "okta_email_customization_preview"
 	OktaIDaaSEmailTemplate                            = "okta_email_template"
 	OktaIDaaSEmailTemplates                           = "okta_email_templates"
+	OktaIDaaSEmailTemplateTestSend                    = "okta_email_template_test_send"
 	OktaIDaaSEmailSMTPServer                          = "okta_email_smtp_server"
+	OktaIDaaSEmailSMTPServerTestResult                = "okta_email_smtp_server_test_result"
 	OktaIDaaSEventHook                                = "okta_event_hook"
 	OktaIDaaSEventHookVerification                    = "okta_event_hook_verification"
 	OktaIDaaSFactor                                   = "okta_factor"
@@ -96,10 +106,13 @@ const (
 	OktaIDaaSIdpSamlKey                               = "okta_idp_saml_key"
 	OktaIDaaSIdpSocial                                = "okta_idp_social"
 	OktaIDaaSInlineHook                               = "okta_inline_hook"
+	OktaIDaaSInlineHookPreview                        = "okta_inline_hook_preview"
 	OktaIDaaSLinkDefinition                           = "okta_link_definition"
 	OktaIDaaSLinkValue                                = "okta_link_value"
 	OktaIDaaSLogStream                                = "okta_log_stream"
 	OktaIDaaSNetworkZone                              = "okta_network_zone"
+	OktaIDaaSNetworkZoneIPServiceCategories           = "okta_network_zone_ip_service_categories"
+	OktaIDaaSNetworkZoneSet                           = "okta_network_zone_set"
 	OktaIDaaSOrgConfiguration                         = "okta_org_configuration"
 	OktaIDaaSOrgSupport                               = "okta_org_support"
 	OktaIDaaSPolicy                                   = "okta_policy"
@@ -115,6 +128,7 @@ const (
 	OktaIDaaSPolicyRuleProfileEnrollment              = "okta_policy_rule_profile_enrollment"
 	OktaIDaaSPolicyRuleSignOn                         = "okta_policy_rule_signon"
 	OktaIDaaSPolicySignOn                             = "okta_policy_signon"
+	OktaIDaaSPreviewErrorPage                         = "okta_preview_error_page"
 	OktaIDaaSProfileMapping                           = "okta_profile_mapping"
 	OktaIDaaSPrincipalRateLimits                      = "okta_principal_rate_limits"
 	OktaIDaaSPushProvider                             = "okta_push_provider"
@@ -123,6 +137,8 @@ const (
 	OktaIDaaSRateLimitWarningThresholdPercentage      = "okta_rate_limit_warning_threshold_percentage"
 	OktaIDaaSRealm                                    = "okta_realm"
 	OktaIDaaSRealmAssignment                          = "okta_realm_assignment"
+	OktaIDaaSRealmAssignmentPreview                   = "okta_realm_assignment_preview"
+	OktaIDaaSRealmUserMigration                       = "okta_realm_user_migration"
 	OktaIDaaSResourceSet                              = "okta_resource_set"
 	OktaIDaaSRoleSubscription                         = "okta_role_subscription"
 	OktaIDaaSSecurityNotificationEmails               = "okta_security_notification_emails"
@@ -158,6 +174,20 @@ const (
 	OktaGovernanceCampaign                            = "okta_campaign"
 	OktaGovernanceEntitlement                         = "okta_entitlement"
 	OktaGovernanceEntitlementBundle                   = "okta_entitlement_bundle"
+	OktaGovernanceCollection                          = "okta_collection"
+	OktaGovernanceCollectionResources                 = "okta_collection_resources"
+	OktaGovernanceCollections                         = "okta_collections"
+	OktaGovernanceRiskRule                            = "okta_risk_rule"
+	OktaGovernanceRiskRules                           = "okta_risk_rules"
+	OktaGovernancePrincipalRiskAssessment             = "okta_principal_risk_assessment"
+	OktaGovernanceResourceOwner                       = "okta_resource_owner"
+	OktaGovernanceLabel                               = "okta_label"
+	OktaGovernanceLabelAssignment                     = "okta_label_assignment"
+	OktaGovernanceGrant                               = "okta_grant"
+	OktaGovernanceGrants                              = "okta_grants"
+	OktaGovernanceDelegate                            = "okta_governance_delegate"
+	OktaGovernanceDelegates                           = "okta_governance_delegates"
+	OktaGovernanceCampaignReport                      = "okta_campaign_report"
 	OktaGovernanceReview                              = "okta_review"
 	OktaGovernancePrincipalEntitlements               = "okta_principal_entitlements"
 	OktaGovernanceRequestCondition                    = "okta_request_condition"
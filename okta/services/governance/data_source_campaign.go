@@ -34,13 +34,26 @@ type campaignDataSourceModel struct {
 	Description          types.String                      `tfsdk:"description"`
 	RecurringCampaignId  types.String                      `tfsdk:"recurring_campaign_id"`
 	RemediationSettings  *campaignRemediationSettingsModel `tfsdk:"remediation_settings"`
-	ResourceSettings     *resourceSettingsModel            `tfsdk:"resource_settings"`
+	ResourceSettings     *resourceSettingsDataSourceModel  `tfsdk:"resource_settings"`
 	ReviewerSettings     *reviewerSettingsModel            `tfsdk:"reviewer_settings"`
 	ScheduleSettings     *scheduleSettingsModel            `tfsdk:"schedule_settings"`
 	NotificationSettings *notificationSettingsModel        `tfsdk:"notification_settings"`
 	PrincipalScope       *principalScopeSettingsModel      `tfsdk:"principal_scope_settings"`
 }
 
+// resourceSettingsDataSourceModel holds the resource settings of a campaign as
+// returned by the API, without the label targeting of the campaign resource.
+type resourceSettingsDataSourceModel struct {
+	Type                               types.String            `tfsdk:"type"`
+	IncludeAdminRoles                  types.Bool              `tfsdk:"include_admin_roles"`
+	IncludeEntitlements                types.Bool              `tfsdk:"include_entitlements"`
+	IndividuallyAssignedAppsOnly       types.Bool              `tfsdk:"individually_assigned_apps_only"`
+	IndividuallyAssignedGroupsOnly     types.Bool              `tfsdk:"individually_assigned_groups_only"`
+	OnlyIncludeOutOfPolicyEntitlements types.Bool              `tfsdk:"only_include_out_of_policy_entitlements"`
+	ExcludedResources                  []excludedResourceModel `tfsdk:"excluded_resources"`
+	TargetResources                    []targetResourceModel   `tfsdk:"target_resources"`
+}
+
 func (d *campaignDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_campaign"
 }
@@ -513,7 +526,7 @@ func (d *campaignDataSource) Read(ctx context.Context, req datasource.ReadReques
 		}
 	}
 
-	data.ResourceSettings = &resourceSettingsModel{
+	data.ResourceSettings = &resourceSettingsDataSourceModel{
 		Type:                               types.StringValue(string(campaign.ResourceSettings.GetType())),
 		IncludeAdminRoles:                  types.BoolValue(campaign.ResourceSettings.GetIncludeAdminRoles()),
 		IncludeEntitlements:                types.BoolValue(campaign.ResourceSettings.GetIncludeEntitlements()),
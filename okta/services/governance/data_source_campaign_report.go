@@ -0,0 +1,529 @@
+package governance
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+const (
+	campaignReportFormatCSV  = "CSV"
+	campaignReportFormatJSON = "JSON"
+)
+
+var _ datasource.DataSource = (*campaignReportDataSource)(nil)
+
+func newCampaignReportDataSource() datasource.DataSource {
+	return &campaignReportDataSource{}
+}
+
+type campaignReportDataSource struct {
+	*config.Config
+}
+
+type campaignReportDataSourceModel struct {
+	Id           types.String                  `tfsdk:"id"`
+	CampaignId   types.String                  `tfsdk:"campaign_id"`
+	OutputPath   types.String                  `tfsdk:"output_path"`
+	OutputFormat types.String                  `tfsdk:"output_format"`
+	Total        types.Int64                   `tfsdk:"total"`
+	Outcomes     []campaignReportOutcomeModel  `tfsdk:"outcomes"`
+	Reviewers    []campaignReportReviewerModel `tfsdk:"reviewers"`
+	Resources    []campaignReportResourceModel `tfsdk:"resources"`
+}
+
+type campaignReportOutcomeModel struct {
+	Decision          types.String `tfsdk:"decision"`
+	RemediationStatus types.String `tfsdk:"remediation_status"`
+	Count             types.Int64  `tfsdk:"count"`
+}
+
+type campaignReportReviewerModel struct {
+	ReviewerId   types.String `tfsdk:"reviewer_id"`
+	ReviewerName types.String `tfsdk:"reviewer_name"`
+	ReviewerType types.String `tfsdk:"reviewer_type"`
+	Total        types.Int64  `tfsdk:"total"`
+	Approved     types.Int64  `tfsdk:"approved"`
+	Revoked      types.Int64  `tfsdk:"revoked"`
+	Unreviewed   types.Int64  `tfsdk:"unreviewed"`
+}
+
+type campaignReportResourceModel struct {
+	ResourceId types.String `tfsdk:"resource_id"`
+	Total      types.Int64  `tfsdk:"total"`
+	Approved   types.Int64  `tfsdk:"approved"`
+	Revoked    types.Int64  `tfsdk:"revoked"`
+	Unreviewed types.Int64  `tfsdk:"unreviewed"`
+}
+
+func (d *campaignReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_campaign_report"
+}
+
+func (d *campaignReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = dataSourceConfiguration(req, resp)
+}
+
+func (d *campaignReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	decisionCounts := map[string]schema.Attribute{
+		"total": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The number of reviews.",
+		},
+		"approved": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The number of reviews with an `APPROVE` decision.",
+		},
+		"revoked": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The number of reviews with a `REVOKE` decision.",
+		},
+		"unreviewed": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The number of reviews without a decision.",
+		},
+	}
+	reviewerAttributes := map[string]schema.Attribute{
+		"reviewer_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the user, or of the group, the reviews are assigned to.",
+		},
+		"reviewer_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The login of the user, or the name of the group, the reviews are assigned to.",
+		},
+		"reviewer_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of the reviewer.",
+		},
+	}
+	resourceAttributes := map[string]schema.Attribute{
+		"resource_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the reviewed resource.",
+		},
+	}
+	for k, v := range decisionCounts {
+		reviewerAttributes[k] = v
+		resourceAttributes[k] = v
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reports the outcome of an access certification campaign: the decisions of all its reviews aggregated by outcome, reviewer and resource. The reviews themselves can be written to a local CSV or JSON file, e.g. as an audit artifact.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the campaign.",
+			},
+			"campaign_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the campaign.",
+			},
+			"output_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a local file the reviews of the campaign are written to, one row or object per review. The file is replaced every time the data source is read.",
+			},
+			"output_format": schema.StringAttribute{
+				Optional:    true,
+				Description: "Format of the file at `output_path`, either `CSV` or `JSON`. Inferred from the extension of `output_path` when not set.",
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(campaignReportFormatCSV, campaignReportFormatJSON),
+				},
+			},
+			"total": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of reviews of the campaign.",
+			},
+			"outcomes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The number of reviews by decision and remediation status.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"decision": schema.StringAttribute{
+							Computed:    true,
+							Description: "The decision of the reviews, `APPROVE`, `REVOKE` or `UNREVIEWED`.",
+						},
+						"remediation_status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The remediation status of the reviews.",
+						},
+						"count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of reviews.",
+						},
+					},
+				},
+			},
+			"reviewers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The number of reviews by reviewer.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: reviewerAttributes,
+				},
+			},
+			"resources": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The number of reviews by resource.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: resourceAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *campaignReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data campaignReportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var out *campaignReportFile
+	if path := data.OutputPath.ValueString(); path != "" {
+		format, err := campaignReportFileFormat(path, data.OutputFormat.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid output_format", err.Error())
+			return
+		}
+		out, err = createCampaignReportFile(path, format)
+		if err != nil {
+			resp.Diagnostics.AddError("Error writing Campaign report", "Could not create "+path+": "+err.Error())
+			return
+		}
+		defer out.abort()
+	}
+
+	report := newCampaignReport()
+	err := forEachCampaignReview(ctx, d.Config, data.CampaignId.ValueString(), func(review *governance.ReviewSparse) error {
+		item := newCampaignReportItem(review)
+		report.add(item)
+		if out != nil {
+			return out.write(item)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Campaign report",
+			"Could not list the reviews of Campaign "+data.CampaignId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if out != nil {
+		if err := out.commit(); err != nil {
+			resp.Diagnostics.AddError("Error writing Campaign report", "Could not write "+data.OutputPath.ValueString()+": "+err.Error())
+			return
+		}
+	}
+
+	report.applyToState(&data)
+	data.Id = data.CampaignId
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// campaignReportItem is a review of the report file, flattened so that CSV
+// and JSON files have the same fields.
+type campaignReportItem struct {
+	ReviewId              string `json:"reviewId"`
+	ResourceId            string `json:"resourceId"`
+	PrincipalId           string `json:"principalId"`
+	PrincipalLogin        string `json:"principalLogin"`
+	EntitlementValueId    string `json:"entitlementValueId"`
+	EntitlementValueName  string `json:"entitlementValueName"`
+	EntitlementBundleId   string `json:"entitlementBundleId"`
+	EntitlementBundleName string `json:"entitlementBundleName"`
+	ReviewerType          string `json:"reviewerType"`
+	ReviewerId            string `json:"reviewerId"`
+	ReviewerName          string `json:"reviewerName"`
+	ReviewerLevel         string `json:"reviewerLevel"`
+	Decision              string `json:"decision"`
+	Decided               string `json:"decided"`
+	RemediationStatus     string `json:"remediationStatus"`
+}
+
+var campaignReportCSVHeader = []string{
+	"reviewId", "resourceId", "principalId", "principalLogin",
+	"entitlementValueId", "entitlementValueName", "entitlementBundleId", "entitlementBundleName",
+	"reviewerType", "reviewerId", "reviewerName", "reviewerLevel",
+	"decision", "decided", "remediationStatus",
+}
+
+func (i campaignReportItem) csvRecord() []string {
+	return []string{
+		i.ReviewId, i.ResourceId, i.PrincipalId, i.PrincipalLogin,
+		i.EntitlementValueId, i.EntitlementValueName, i.EntitlementBundleId, i.EntitlementBundleName,
+		i.ReviewerType, i.ReviewerId, i.ReviewerName, i.ReviewerLevel,
+		i.Decision, i.Decided, i.RemediationStatus,
+	}
+}
+
+func newCampaignReportItem(review *governance.ReviewSparse) campaignReportItem {
+	item := campaignReportItem{
+		ReviewId:          review.GetId(),
+		ResourceId:        review.GetResourceId(),
+		PrincipalId:       review.PrincipalProfile.GetId(),
+		PrincipalLogin:    review.PrincipalProfile.GetLogin(),
+		ReviewerType:      string(review.GetReviewerType()),
+		Decision:          string(review.GetDecision()),
+		RemediationStatus: string(review.GetRemediationStatus()),
+	}
+	if item.PrincipalLogin == "" {
+		item.PrincipalLogin = review.PrincipalProfile.GetEmail()
+	}
+	if review.EntitlementValue != nil {
+		item.EntitlementValueId = review.EntitlementValue.GetId()
+		item.EntitlementValueName = review.EntitlementValue.GetName()
+	}
+	if review.EntitlementBundle != nil {
+		item.EntitlementBundleId = review.EntitlementBundle.GetId()
+		item.EntitlementBundleName = review.EntitlementBundle.GetName()
+	}
+	if review.ReviewerProfile != nil {
+		item.ReviewerId = review.ReviewerProfile.GetId()
+		item.ReviewerName = review.ReviewerProfile.GetLogin()
+		if item.ReviewerName == "" {
+			item.ReviewerName = review.ReviewerProfile.GetEmail()
+		}
+	} else if review.ReviewerGroupProfile != nil {
+		item.ReviewerId = review.ReviewerGroupProfile.GetGroupId()
+		item.ReviewerName = review.ReviewerGroupProfile.GetName()
+	}
+	if review.CurrentReviewerLevel != nil {
+		item.ReviewerLevel = string(*review.CurrentReviewerLevel)
+	}
+	if review.Decided != nil {
+		item.Decided = review.Decided.UTC().Format(time.RFC3339)
+	}
+	return item
+}
+
+// campaignReportFileFormat returns the format of the report file, either the
+// one given or the one implied by the file extension.
+func campaignReportFileFormat(path, format string) (string, error) {
+	if format != "" {
+		return strings.ToUpper(format), nil
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return campaignReportFormatCSV, nil
+	case ".json":
+		return campaignReportFormatJSON, nil
+	}
+	return "", fmt.Errorf("unable to infer the format of %q from its extension, set output_format to %s or %s", path, campaignReportFormatCSV, campaignReportFormatJSON)
+}
+
+// campaignReportFile writes the reviews of a campaign as they are listed. The
+// reviews go to a temporary file that replaces the report file once all of
+// them are written, so a failed read never leaves a truncated report behind.
+type campaignReportFile struct {
+	path   string
+	format string
+	file   *os.File
+	buf    *bufio.Writer
+	csv    *csv.Writer
+	count  int
+}
+
+func createCampaignReportFile(path, format string) (*campaignReportFile, error) {
+	file, err := os.OpenFile(path+".tmp", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	out := &campaignReportFile{path: path, format: format, file: file, buf: bufio.NewWriter(file)}
+	if format == campaignReportFormatCSV {
+		out.csv = csv.NewWriter(out.buf)
+		err = out.csv.Write(campaignReportCSVHeader)
+	} else {
+		_, err = out.buf.WriteString("[")
+	}
+	if err != nil {
+		out.abort()
+		return nil, err
+	}
+	return out, nil
+}
+
+func (f *campaignReportFile) write(item campaignReportItem) error {
+	f.count++
+	if f.csv != nil {
+		return f.csv.Write(item.csvRecord())
+	}
+	b, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	sep := ",\n  "
+	if f.count == 1 {
+		sep = "\n  "
+	}
+	if _, err := f.buf.WriteString(sep); err != nil {
+		return err
+	}
+	_, err = f.buf.Write(b)
+	return err
+}
+
+// commit finishes the report and moves it to its path.
+func (f *campaignReportFile) commit() error {
+	var err error
+	if f.csv != nil {
+		f.csv.Flush()
+		err = f.csv.Error()
+	} else if f.count > 0 {
+		_, err = f.buf.WriteString("\n]\n")
+	} else {
+		_, err = f.buf.WriteString("]\n")
+	}
+	if err == nil {
+		err = f.buf.Flush()
+	}
+	if closeErr := f.file.Close(); err == nil {
+		err = closeErr
+	}
+	f.file = nil
+	if err != nil {
+		os.Remove(f.path + ".tmp")
+		return err
+	}
+	return os.Rename(f.path+".tmp", f.path)
+}
+
+// abort removes the temporary file of a report that was not committed.
+func (f *campaignReportFile) abort() {
+	if f.file == nil {
+		return
+	}
+	f.file.Close()
+	f.file = nil
+	os.Remove(f.path + ".tmp")
+}
+
+type campaignReportCounts struct {
+	total, approved, revoked, unreviewed int64
+}
+
+func (c *campaignReportCounts) add(decision string) {
+	c.total++
+	switch governance.Decision(decision) {
+	case governance.DECISION_APPROVE:
+		c.approved++
+	case governance.DECISION_REVOKE:
+		c.revoked++
+	default:
+		c.unreviewed++
+	}
+}
+
+type campaignReportReviewer struct {
+	name, reviewerType string
+	campaignReportCounts
+}
+
+type campaignReportOutcome struct {
+	decision, remediationStatus string
+}
+
+// campaignReport aggregates the reviews of a campaign, it only keeps one
+// counter per reviewer, resource and outcome.
+type campaignReport struct {
+	total     int64
+	outcomes  map[campaignReportOutcome]int64
+	reviewers map[string]*campaignReportReviewer
+	resources map[string]*campaignReportCounts
+}
+
+func newCampaignReport() *campaignReport {
+	return &campaignReport{
+		outcomes:  map[campaignReportOutcome]int64{},
+		reviewers: map[string]*campaignReportReviewer{},
+		resources: map[string]*campaignReportCounts{},
+	}
+}
+
+func (r *campaignReport) add(item campaignReportItem) {
+	r.total++
+	r.outcomes[campaignReportOutcome{item.Decision, item.RemediationStatus}]++
+
+	reviewer, ok := r.reviewers[item.ReviewerId]
+	if !ok {
+		reviewer = &campaignReportReviewer{name: item.ReviewerName, reviewerType: item.ReviewerType}
+		r.reviewers[item.ReviewerId] = reviewer
+	}
+	reviewer.add(item.Decision)
+
+	resource, ok := r.resources[item.ResourceId]
+	if !ok {
+		resource = &campaignReportCounts{}
+		r.resources[item.ResourceId] = resource
+	}
+	resource.add(item.Decision)
+}
+
+// applyToState sets the aggregates sorted by their keys, so the report does
+// not change with the order the reviews are listed in.
+func (r *campaignReport) applyToState(data *campaignReportDataSourceModel) {
+	data.Total = types.Int64Value(r.total)
+
+	outcomes := make([]campaignReportOutcome, 0, len(r.outcomes))
+	for outcome := range r.outcomes {
+		outcomes = append(outcomes, outcome)
+	}
+	sort.Slice(outcomes, func(i, j int) bool {
+		if outcomes[i].decision != outcomes[j].decision {
+			return outcomes[i].decision < outcomes[j].decision
+		}
+		return outcomes[i].remediationStatus < outcomes[j].remediationStatus
+	})
+	data.Outcomes = make([]campaignReportOutcomeModel, len(outcomes))
+	for i, outcome := range outcomes {
+		data.Outcomes[i] = campaignReportOutcomeModel{
+			Decision:          types.StringValue(outcome.decision),
+			RemediationStatus: types.StringValue(outcome.remediationStatus),
+			Count:             types.Int64Value(r.outcomes[outcome]),
+		}
+	}
+
+	data.Reviewers = make([]campaignReportReviewerModel, 0, len(r.reviewers))
+	for _, id := range slices.Sorted(maps.Keys(r.reviewers)) {
+		reviewer := r.reviewers[id]
+		data.Reviewers = append(data.Reviewers, campaignReportReviewerModel{
+			ReviewerId:   types.StringValue(id),
+			ReviewerName: types.StringValue(reviewer.name),
+			ReviewerType: types.StringValue(reviewer.reviewerType),
+			Total:        types.Int64Value(reviewer.total),
+			Approved:     types.Int64Value(reviewer.approved),
+			Revoked:      types.Int64Value(reviewer.revoked),
+			Unreviewed:   types.Int64Value(reviewer.unreviewed),
+		})
+	}
+
+	data.Resources = make([]campaignReportResourceModel, 0, len(r.resources))
+	for _, id := range slices.Sorted(maps.Keys(r.resources)) {
+		resource := r.resources[id]
+		data.Resources = append(data.Resources, campaignReportResourceModel{
+			ResourceId: types.StringValue(id),
+			Total:      types.Int64Value(resource.total),
+			Approved:   types.Int64Value(resource.approved),
+			Revoked:    types.Int64Value(resource.revoked),
+			Unreviewed: types.Int64Value(resource.unreviewed),
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package governance_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccDataSourceOktaCampaignReport_read(t *testing.T) {
+	acctest.RequireSKU(t, config.SKUGovernance)
+	mgr := newFixtureManager("data-sources", resources.OktaGovernanceCampaignReport, t.Name())
+	outputDir := filepath.ToSlash(t.TempDir())
+	tfConfig := strings.ReplaceAll(mgr.GetFixtures("datasource.tf", t), "replace_with_output_dir", outputDir)
+	dataSourceName := fmt.Sprintf("data.%s.csv", resources.OktaGovernanceCampaignReport)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "total", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "outcomes.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "outcomes.0.decision", "APPROVE"),
+					resource.TestCheckResourceAttr(dataSourceName, "outcomes.0.remediation_status", "NONE"),
+					resource.TestCheckResourceAttr(dataSourceName, "outcomes.0.count", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "outcomes.1.decision", "REVOKE"),
+					resource.TestCheckResourceAttr(dataSourceName, "outcomes.1.remediation_status", "SUCCESS"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.0.reviewer_type", "GROUP"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.0.reviewer_name", "App B Owners"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.2.reviewer_id", "00uwvhe0y85W3Iq6x1d7"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.2.total", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.2.approved", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "reviewers.2.revoked", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.1.resource_id", "0oaws4am895IZbn6Q1d7"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.1.unreviewed", "1"),
+					testAccCheckCampaignReportCSV(filepath.Join(outputDir, "report.csv")),
+					testAccCheckCampaignReportJSON(filepath.Join(outputDir, "report.out")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCampaignReportCSV(path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		records, err := csv.NewReader(f).ReadAll()
+		if err != nil {
+			return err
+		}
+		if len(records) != 5 {
+			return fmt.Errorf("expected a header and 4 reviews in %s, got %d rows", path, len(records))
+		}
+		expected := "icrrpt0004F2JzF1d7a1,0oaws4am895IZbn6Q1d7,00uo0ntv2fYJkUwLO1d7,other.user@example.com,entzcbqd8lcD3BRWR1d6,Admin,,,USER,00uo0ntv9pQzGmXbK1d7,second.reviewer@example.com,FIRST,APPROVE,2026-03-29T14:10:00Z,NONE"
+		if got := strings.Join(records[4], ","); got != expected {
+			return fmt.Errorf("expected the last review of %s to be %q, got %q", path, expected, got)
+		}
+		return nil
+	}
+}
+
+func testAccCheckCampaignReportJSON(path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var items []map[string]string
+		if err := json.Unmarshal(b, &items); err != nil {
+			return fmt.Errorf("invalid JSON report %s: %w", path, err)
+		}
+		if len(items) != 4 {
+			return fmt.Errorf("expected 4 reviews in %s, got %d", path, len(items))
+		}
+		if items[2]["reviewerName"] != "App B Owners" || items[2]["decision"] != "UNREVIEWED" || items[2]["decided"] != "" {
+			return fmt.Errorf("unexpected group review in %s: %v", path, items[2])
+		}
+		return nil
+	}
+}
@@ -0,0 +1,163 @@
+package governance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+)
+
+var _ datasource.DataSource = (*collectionDataSource)(nil)
+
+func newCollectionDataSource() datasource.DataSource {
+	return &collectionDataSource{}
+}
+
+type collectionDataSource struct {
+	*config.Config
+}
+
+func (d *collectionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection"
+}
+
+func (d *collectionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = dataSourceConfiguration(req, resp)
+}
+
+func (d *collectionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := collectionDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the collection to retrieve. Conflicts with `name`.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The name of the collection to retrieve. Conflicts with `id`.",
+	}
+	resp.Schema = schema.Schema{
+		Description: "Get a resource collection by ID or name.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *collectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data collectionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, name := data.Id.ValueString(), data.Name.ValueString()
+	if (id == "") == (name == "") {
+		resp.Diagnostics.AddError("Invalid configuration", "Exactly one of `id` or `name` must be set.")
+		return
+	}
+
+	client := d.OktaGovernanceClient.OktaGovernanceSDKClient()
+	if id != "" {
+		collection, _, err := client.CollectionsAPI.GetCollection(ctx, id).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading Collection",
+				"Could not read Collection with ID "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+		applyCollectionToState(collection, &data)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// name only supports the sw and co operators, look for the exact match
+	collections, err := listCollections(ctx, client, fmt.Sprintf("name sw %q", name))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Collections",
+			"Could not list Collections, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	for i := range collections {
+		if collections[i].GetName() == name {
+			applyCollectionToState(&collections[i], &data)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError("Collection not found", fmt.Sprintf("No collection named %q was found.", name))
+}
+
+// collectionDataSourceAttributes returns the computed attributes of a
+// collection, shared by the collection data sources.
+func collectionDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the collection.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the collection.",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "The description of the collection.",
+		},
+		"created": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ISO 8601 formatted date and time when the collection was created.",
+		},
+		"created_by": schema.StringAttribute{
+			Computed:    true,
+			Description: "The id of the Okta user who created the collection.",
+		},
+		"last_updated": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ISO 8601 formatted date and time when the collection was last updated.",
+		},
+		"last_updated_by": schema.StringAttribute{
+			Computed:    true,
+			Description: "The id of the Okta user who last updated the collection.",
+		},
+		"principal_assignment_count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of principals the collection is assigned to.",
+		},
+		"application_count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of applications in the collection.",
+		},
+	}
+}
+
+// listCollections returns all the collections matching the filter, following
+// the pagination cursor.
+func listCollections(ctx context.Context, client *governance.OktaGovernanceAPIClient, filter string) ([]governance.CollectionFull, error) {
+	var all []governance.CollectionFull
+	after := ""
+	for {
+		listReq := client.CollectionsAPI.ListCollections(ctx).Limit(int32(utils.DefaultPaginationLimit))
+		if filter != "" {
+			listReq = listReq.Filter(filter)
+		}
+		if after != "" {
+			listReq = listReq.After(after)
+		}
+		list, _, err := listReq.Execute()
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list.GetData()...)
+		after = nextPageCursor(list.Links)
+		if after == "" {
+			return all, nil
+		}
+	}
+}
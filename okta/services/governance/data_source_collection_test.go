@@ -0,0 +1,33 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccDataSourceOktaCollection_read(t *testing.T) {
+	acctest.RequireSKU(t, config.SKUGovernance)
+	mgr := newFixtureManager("data-sources", resources.OktaGovernanceCollection, t.Name())
+	tfConfig := mgr.GetFixtures("datasource.tf", t)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.okta_collection.by_id", "id", "okta_collection.test", "id"),
+					resource.TestCheckResourceAttr("data.okta_collection.by_id", "name", "collection data source test"),
+					resource.TestCheckResourceAttr("data.okta_collection.by_id", "description", "testing collection data source"),
+					resource.TestCheckResourceAttrPair("data.okta_collection.by_name", "id", "okta_collection.test", "id"),
+				),
+			},
+		},
+	})
+}
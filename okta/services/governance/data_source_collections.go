@@ -0,0 +1,81 @@
+package governance
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+var _ datasource.DataSource = (*collectionsDataSource)(nil)
+
+func newCollectionsDataSource() datasource.DataSource {
+	return &collectionsDataSource{}
+}
+
+type collectionsDataSource struct {
+	*config.Config
+}
+
+type collectionsDataSourceModel struct {
+	Id          types.String              `tfsdk:"id"`
+	Filter      types.String              `tfsdk:"filter"`
+	Collections []collectionResourceModel `tfsdk:"collections"`
+}
+
+func (d *collectionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collections"
+}
+
+func (d *collectionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = dataSourceConfiguration(req, resp)
+}
+
+func (d *collectionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List resource collections.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this data source.",
+			},
+			"filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter expression on the `id` or `name` of the collections, e.g. `name sw \"Finance\"`. The `sw` and `co` operators are supported for `name`, `eq` for `id`. All collections are returned when not set.",
+			},
+			"collections": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The collections.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: collectionDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *collectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data collectionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collections, err := listCollections(ctx, d.OktaGovernanceClient.OktaGovernanceSDKClient(), data.Filter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Collections",
+			"Could not list Collections, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Collections = make([]collectionResourceModel, len(collections))
+	for i := range collections {
+		applyCollectionToState(&collections[i], &data.Collections[i])
+	}
+	data.Id = types.StringValue("collections")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,32 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccDataSourceOktaCollections_read(t *testing.T) {
+	acctest.RequireSKU(t, config.SKUGovernance)
+	mgr := newFixtureManager("data-sources", resources.OktaGovernanceCollections, t.Name())
+	tfConfig := mgr.GetFixtures("datasource.tf", t)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okta_collections.test", "collections.#", "1"),
+					resource.TestCheckResourceAttrPair("data.okta_collections.test", "collections.0.id", "okta_collection.test", "id"),
+					resource.TestCheckResourceAttr("data.okta_collections.test", "collections.0.name", "collections data source test"),
+				),
+			},
+		},
+	})
+}
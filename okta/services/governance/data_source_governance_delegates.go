@@ -0,0 +1,138 @@
+package governance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+var _ datasource.DataSource = (*governanceDelegatesDataSource)(nil)
+
+func newGovernanceDelegatesDataSource() datasource.DataSource {
+	return &governanceDelegatesDataSource{}
+}
+
+type governanceDelegatesDataSource struct {
+	*config.Config
+}
+
+type governanceDelegatesDataSourceModel struct {
+	Id          types.String                      `tfsdk:"id"`
+	PrincipalId types.String                      `tfsdk:"principal_id"`
+	DelegateId  types.String                      `tfsdk:"delegate_id"`
+	Delegates   []governanceDelegateResourceModel `tfsdk:"delegates"`
+}
+
+func (d *governanceDelegatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_governance_delegates"
+}
+
+func (d *governanceDelegatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = dataSourceConfiguration(req, resp)
+}
+
+func (d *governanceDelegatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List the delegate appointments active now, e.g. to check that the fallback reviewer of a campaign is not out of office.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this data source.",
+			},
+			"principal_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the appointment of the user delegating their duties.",
+			},
+			"delegate_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the appointments of the user appointed as delegate.",
+			},
+			"delegates": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The active delegate appointments.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the user delegating their duties.",
+						},
+						"principal_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the user delegating their duties.",
+						},
+						"delegate_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the user appointed as delegate.",
+						},
+						"start_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date and time when the appointment started.",
+						},
+						"end_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date and time when the appointment ends.",
+						},
+						"note": schema.StringAttribute{
+							Computed:    true,
+							Description: "The note that describes the appointment.",
+						},
+						"appointment_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the delegate appointment.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *governanceDelegatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data governanceDelegatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principalID, delegateID := data.PrincipalId.ValueString(), data.DelegateId.ValueString()
+	var filters []string
+	if principalID != "" {
+		filters = append(filters, fmt.Sprintf("delegator.externalId eq %q", principalID))
+	}
+	if delegateID != "" {
+		filters = append(filters, fmt.Sprintf("delegate.externalId eq %q", delegateID))
+	}
+	appointments, err := listDelegateAppointments(ctx, d.Config, strings.Join(filters, " AND "))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Governance Delegates",
+			"Could not list delegate appointments, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// the API lists scheduled and expired appointments as well
+	now := d.TimeOperations.Now()
+	data.Delegates = []governanceDelegateResourceModel{}
+	for i := range appointments {
+		appointment := &appointments[i]
+		if appointment.StartTime != nil && appointment.StartTime.After(now) {
+			continue
+		}
+		if appointment.EndTime != nil && !appointment.EndTime.After(now) {
+			continue
+		}
+		delegate := governanceDelegateResourceModel{
+			PrincipalId: types.StringValue(appointment.Delegator.GetExternalId()),
+		}
+		applyGovernanceDelegateToState(appointment, &delegate)
+		data.Delegates = append(data.Delegates, delegate)
+	}
+	data.Id = types.StringValue(principalID + "|" + delegateID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,33 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccDataSourceOktaGovernanceDelegates_read(t *testing.T) {
+	acctest.RequireSKU(t, config.SKUGovernance)
+	mgr := newFixtureManager("data-sources", resources.OktaGovernanceDelegates, t.Name())
+	tfConfig := mgr.GetFixtures("datasource.tf", t)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					// the scheduled appointment of another user is not listed
+					resource.TestCheckResourceAttr("data.okta_governance_delegates.test", "delegates.#", "1"),
+					resource.TestCheckResourceAttr("data.okta_governance_delegates.test", "delegates.0.principal_id", "00unkw1sfbTw08c0g1d7"),
+					resource.TestCheckResourceAttrPair("data.okta_governance_delegates.test", "delegates.0.appointment_id", "okta_governance_delegate.test", "appointment_id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,182 @@
+package governance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+var _ datasource.DataSource = (*grantsDataSource)(nil)
+
+func newGrantsDataSource() datasource.DataSource {
+	return &grantsDataSource{}
+}
+
+type grantsDataSource struct {
+	*config.Config
+}
+
+type grantsDataSourceModel struct {
+	Id          types.String         `tfsdk:"id"`
+	PrincipalId types.String         `tfsdk:"principal_id"`
+	ResourceOrn types.String         `tfsdk:"resource_orn"`
+	Grants      []grantResourceModel `tfsdk:"grants"`
+}
+
+func (d *grantsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grants"
+}
+
+func (d *grantsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = dataSourceConfiguration(req, resp)
+}
+
+func (d *grantsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List the active grants of a user, of a resource, or of a user on a resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this data source.",
+			},
+			"principal_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the user whose grants are listed. At least one of `principal_id` or `resource_orn` must be set.",
+			},
+			"resource_orn": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ORN of the app whose grants are listed. At least one of `principal_id` or `resource_orn` must be set.",
+			},
+			"grants": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The grants.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the grant.",
+						},
+						"principal_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the user the access is granted to.",
+						},
+						"principal_orn": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ORN of the user the access is granted to.",
+						},
+						"resource_orn": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ORN of the app of the grant.",
+						},
+						"entitlement_bundle_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the granted entitlement bundle.",
+						},
+						"scheduled_revocation": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date and time when the grant is revoked.",
+						},
+						"grant_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the grant.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the grant.",
+						},
+						"entitlements": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The granted entitlements and values of `CUSTOM` grants.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:    true,
+										Description: "Entitlement ID",
+									},
+									"values": schema.ListNestedAttribute{
+										Computed: true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"id": schema.StringAttribute{
+													Computed:    true,
+													Description: "Entitlement value ID",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *grantsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data grantsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principalID, resourceOrn := data.PrincipalId.ValueString(), data.ResourceOrn.ValueString()
+	if principalID == "" && resourceOrn == "" {
+		resp.Diagnostics.AddError("Invalid configuration", "At least one of `principal_id` or `resource_orn` must be set.")
+		return
+	}
+
+	// the API requires a filter
+	var filters []string
+	if principalID != "" {
+		filters = append(filters, fmt.Sprintf("targetPrincipal.externalId eq %q AND targetPrincipal.type eq %q", principalID, governance.PRINCIPALTYPE_OKTA_USER))
+	}
+	if resourceOrn != "" {
+		filters = append(filters, fmt.Sprintf("targetResourceOrn eq %q", resourceOrn))
+	}
+	grants, err := listGrants(ctx, d.Config, strings.Join(filters, " AND "))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Grants",
+			"Could not list Grants, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Grants = make([]grantResourceModel, len(grants))
+	for i := range grants {
+		applyGrantToState(&grants[i], &data.Grants[i])
+	}
+	data.Id = types.StringValue(principalID + "|" + resourceOrn)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listGrants returns all the active grants matching the filter, following the
+// pagination cursor.
+func listGrants(ctx context.Context, c *config.Config, filter string) ([]governance.GrantFull, error) {
+	var all []governance.GrantFull
+	after := ""
+	for {
+		var list governance.GrantsList
+		_, err := doGovernanceRequest(ctx, c, http.MethodGet, governanceListPath(grantsPath, filter, after), nil, &list)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list.GetData()...)
+		after = ""
+		if list.Links != nil {
+			after = linkPageCursor(list.Links.Next)
+		}
+		if after == "" {
+			return all, nil
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccDataSourceOktaGrants_read(t *testing.T) {
+	acctest.RequireSKU(t, config.SKUGovernance)
+	mgr := newFixtureManager("data-sources", resources.OktaGovernanceGrants, t.Name())
+	tfConfig := mgr.GetFixtures("datasource.tf", t)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okta_grants.by_principal", "grants.#", "2"),
+					resource.TestCheckResourceAttr("data.okta_grants.by_resource", "grants.#", "1"),
+					resource.TestCheckResourceAttrPair("data.okta_grants.by_resource", "grants.0.id", "okta_grant.test", "id"),
+					resource.TestCheckResourceAttr("data.okta_grants.by_resource", "grants.0.entitlements.0.values.0.id", "entzcbqd8lcD3BRWR1d6"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,133 @@
+package governance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+var _ datasource.DataSource = (*labelDataSource)(nil)
+
+func newLabelDataSource() datasource.DataSource {
+	return &labelDataSource{}
+}
+
+type labelDataSource struct {
+	*config.Config
+}
+
+func (d *labelDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_label"
+}
+
+func (d *labelDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = dataSourceConfiguration(req, resp)
+}
+
+func (d *labelDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get a governance label by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the label to retrieve. Conflicts with `name`.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the label to retrieve. Conflicts with `id`.",
+			},
+			"values": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The values of the label.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the label value.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the label value.",
+						},
+						"background_color": schema.StringAttribute{
+							Computed:    true,
+							Description: "The background color of the label value.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *labelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data labelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, name := data.Id.ValueString(), data.Name.ValueString()
+	if (id == "") == (name == "") {
+		resp.Diagnostics.AddError("Invalid configuration", "Exactly one of `id` or `name` must be set.")
+		return
+	}
+
+	if id != "" {
+		var label governance.Label
+		_, err := doGovernanceRequest(ctx, d.Config, http.MethodGet, labelsPath+"/"+id, nil, &label)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading Label",
+				"Could not read Label with ID "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+		applyLabelToState(&label, &data)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// labels can only be filtered on the names of their values
+	labels, err := listLabels(ctx, d.Config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing Labels",
+			"Could not list Labels, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	for i := range labels {
+		if labels[i].GetName() == name {
+			applyLabelToState(&labels[i], &data)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+	resp.Diagnostics.AddError("Label not found", fmt.Sprintf("No label named %q was found.", name))
+}
+
+// listLabels returns all the labels, following the pagination cursor.
+func listLabels(ctx context.Context, c *config.Config) ([]governance.Label, error) {
+	var all []governance.Label
+	after := ""
+	for {
+		var list governance.ListLabels
+		_, err := doGovernanceRequest(ctx, c, http.MethodGet, governanceListPath(labelsPath, "", after), nil, &list)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list.GetData()...)
+		after = nextPageCursor(&list.Links)
+		if after == "" {
+			return all, nil
+		}
+	}
+}
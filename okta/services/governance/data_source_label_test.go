@@ -0,0 +1,34 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccDataSourceOktaLabel_read(t *testing.T) {
+	acctest.RequireSKU(t, config.SKUGovernance)
+	mgr := newFixtureManager("data-sources", resources.OktaGovernanceLabel, t.Name())
+	tfConfig := mgr.GetFixtures("datasource.tf", t)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.okta_label.by_id", "id", "okta_label.test", "id"),
+					resource.TestCheckResourceAttr("data.okta_label.by_id", "name", "label data source test"),
+					resource.TestCheckResourceAttrPair("data.okta_label.by_id", "values.0.id", "okta_label.test", "values.0.id"),
+					resource.TestCheckResourceAttr("data.okta_label.by_id", "values.0.background_color", "yellow"),
+					resource.TestCheckResourceAttrPair("data.okta_label.by_name", "id", "okta_label.test", "id"),
+				),
+			},
+		},
+	})
+}
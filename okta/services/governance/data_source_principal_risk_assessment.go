@@ -0,0 +1,165 @@
+package governance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+var _ datasource.DataSource = (*principalRiskAssessmentDataSource)(nil)
+
+func newPrincipalRiskAssessmentDataSource() datasource.DataSource {
+	return &principalRiskAssessmentDataSource{}
+}
+
+type principalRiskAssessmentDataSource struct {
+	*config.Config
+}
+
+type principalRiskAssessmentDataSourceModel struct {
+	Id           types.String                  `tfsdk:"id"`
+	UserId       types.String                  `tfsdk:"user_id"`
+	PrincipalOrn types.String                  `tfsdk:"principal_orn"`
+	ResourceOrn  types.String                  `tfsdk:"resource_orn"`
+	HasConflicts types.Bool                    `tfsdk:"has_conflicts"`
+	Conflicts    []riskAssessmentConflictModel `tfsdk:"conflicts"`
+}
+
+type riskAssessmentConflictModel struct {
+	RuleId      types.String `tfsdk:"rule_id"`
+	RuleName    types.String `tfsdk:"rule_name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	ResourceOrn types.String `tfsdk:"resource_orn"`
+}
+
+func (d *principalRiskAssessmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_principal_risk_assessment"
+}
+
+func (d *principalRiskAssessmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = dataSourceConfiguration(req, resp)
+}
+
+func (d *principalRiskAssessmentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluates a user against the risk rules, reporting the rules the user would be in conflict with if they were granted access to a resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this data source.",
+			},
+			"user_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the user to evaluate. Conflicts with `principal_orn`.",
+			},
+			"principal_orn": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ORN of the user to evaluate. Conflicts with `user_id`, which builds the ORN from the organization of `resource_orn`.",
+			},
+			"resource_orn": schema.StringAttribute{
+				Required:    true,
+				Description: "The ORN of the resource the user would be granted access to. The resource can be an app, a collection, or an entitlement bundle.",
+			},
+			"has_conflicts": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the user would be in conflict with any risk rule.",
+			},
+			"conflicts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The risk rules the user would be in conflict with.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the risk rule.",
+						},
+						"rule_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the risk rule.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the risk rule.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the risk rule.",
+						},
+						"resource_orn": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ORN of the resource of the risk rule.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *principalRiskAssessmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data principalRiskAssessmentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID, principalOrn := data.UserId.ValueString(), data.PrincipalOrn.ValueString()
+	if (userID == "") == (principalOrn == "") {
+		resp.Diagnostics.AddError("Invalid configuration", "Exactly one of `user_id` or `principal_orn` must be set.")
+		return
+	}
+	if userID != "" {
+		var err error
+		principalOrn, err = UserOrnFromResourceOrn(data.ResourceOrn.ValueString(), userID)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid configuration", err.Error())
+			return
+		}
+	}
+
+	assessment, _, err := d.OktaGovernanceClient.OktaGovernanceSDKClient().RiskRulesAPI.GeneratePotentialRiskAssessments(ctx).PotentialRiskAssessmentRequest(governance.PotentialRiskAssessmentRequest{
+		PrincipalOrn: principalOrn,
+		ResourceOrn:  data.ResourceOrn.ValueString(),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error generating Risk Assessment",
+			"Could not generate the risk assessment of "+principalOrn+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Conflicts = []riskAssessmentConflictModel{}
+	for _, conflict := range assessment.GetData() {
+		data.Conflicts = append(data.Conflicts, riskAssessmentConflictModel{
+			RuleId:      types.StringPointerValue(conflict.RuleId),
+			RuleName:    types.StringPointerValue(conflict.RuleName),
+			Description: types.StringPointerValue(conflict.Description),
+			Type:        types.StringPointerValue(conflict.Type),
+			ResourceOrn: types.StringPointerValue(conflict.ResourceOrn),
+		})
+	}
+	data.PrincipalOrn = types.StringValue(principalOrn)
+	data.HasConflicts = types.BoolValue(len(data.Conflicts) > 0)
+	data.Id = types.StringValue(principalOrn + "|" + data.ResourceOrn.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// UserOrnFromResourceOrn returns the ORN of a user of the organization of the
+// resource, e.g. orn:okta:directory:00o1n8sbwArJ7OQRw406:users:00u1ktfFMZ5HNoj7k0g4
+// for a resource orn:okta:idp:00o1n8sbwArJ7OQRw406:apps:salesforce:0oafxqCAJWWGELFTYASJ.
+func UserOrnFromResourceOrn(resourceOrn, userID string) (string, error) {
+	parts := strings.Split(resourceOrn, ":")
+	if len(parts) < 4 || parts[0] != "orn" || parts[1] == "" || parts[3] == "" {
+		return "", fmt.Errorf("could not find the organization of resource ORN %q, set principal_orn instead of user_id", resourceOrn)
+	}
+	return fmt.Sprintf("orn:%s:directory:%s:users:%s", parts[1], parts[3], userID), nil
+}
@@ -0,0 +1,46 @@
+package governance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+	"github.com/okta/terraform-provider-okta/okta/services/governance"
+)
+
+func TestAccDataSourceOktaPrincipalRiskAssessment_read(t *testing.T) {
+	acctest.RequireSKU(t, config.SKUGovernance)
+	mgr := newFixtureManager("data-sources", resources.OktaGovernancePrincipalRiskAssessment, t.Name())
+	tfConfig := mgr.GetFixtures("datasource.tf", t)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okta_principal_risk_assessment.test", "principal_orn", "orn:oktapreview:directory:00onkw1sbuAh3Q06I1d7:users:00unkw1sfbTw08c0g1d7"),
+					resource.TestCheckResourceAttr("data.okta_principal_risk_assessment.test", "has_conflicts", "false"),
+					resource.TestCheckResourceAttr("data.okta_principal_risk_assessment.test", "conflicts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestUserOrnFromResourceOrn(t *testing.T) {
+	got, err := governance.UserOrnFromResourceOrn("orn:okta:idp:00o1n8sbwArJ7OQRw406:apps:salesforce:0oafxqCAJWWGELFTYASJ", "00u1ktfFMZ5HNoj7k0g4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "orn:okta:directory:00o1n8sbwArJ7OQRw406:users:00u1ktfFMZ5HNoj7k0g4"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if _, err := governance.UserOrnFromResourceOrn("0oafxqCAJWWGELFTYASJ", "00u1ktfFMZ5HNoj7k0g4"); err == nil {
+		t.Error("expected an error for a resource that is not an ORN")
+	}
+}
@@ -2,6 +2,7 @@ package governance
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
 	"github.com/okta/terraform-provider-okta/okta/config"
 )
 
@@ -30,8 +32,9 @@ func (d *requestSequenceDataSource) Schema(ctx context.Context, req datasource.S
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "The unique identifier for the request sequence. This is typically the sequence ID in Okta.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The unique identifier for the request sequence. This is typically the sequence ID in Okta. Conflicts with `name`.",
 			},
 			"resource_id": schema.StringAttribute{
 				Required:    true,
@@ -46,8 +49,9 @@ func (d *requestSequenceDataSource) Schema(ctx context.Context, req datasource.S
 				Description: "Link to edit the request sequence.",
 			},
 			"name": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "Name of the request sequence.",
+				Description: "Name of the request sequence. Conflicts with `id`.",
 			},
 			"compatible_resource_types": schema.ListAttribute{
 				Computed:    true,
@@ -85,17 +89,46 @@ func (d *requestSequenceDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	// Read API call logic
-	readRequestSeqResp, _, err := d.OktaGovernanceClient.OktaGovernanceSDKClient().RequestSequencesAPI.GetResourceRequestSequenceV2(ctx, data.ResourceId.ValueString(), data.Id.ValueString()).Execute()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading Principal Entitlements",
-			"Could not read Principal Entitlements, unexpected error: "+err.Error(),
-		)
+	id, name := data.Id.ValueString(), data.Name.ValueString()
+	if (id == "") == (name == "") {
+		resp.Diagnostics.AddError("Invalid configuration", "Exactly one of `id` or `name` must be set.")
 		return
 	}
 
-	// Example Data value setting
+	// Read API call logic
+	var readRequestSeqResp *governance.RequestSequence
+	if id != "" {
+		var err error
+		readRequestSeqResp, _, err = d.OktaGovernanceClient.OktaGovernanceSDKClient().RequestSequencesAPI.GetResourceRequestSequenceV2(ctx, data.ResourceId.ValueString(), id).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading Request Sequence",
+				"Could not read Request Sequence, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	} else {
+		list, _, err := d.OktaGovernanceClient.OktaGovernanceSDKClient().RequestSequencesAPI.ListResourceRequestSequencesV2(ctx, data.ResourceId.ValueString()).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing Request Sequences",
+				"Could not list the Request Sequences of resource "+data.ResourceId.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+		for i, sequence := range list.GetData() {
+			if sequence.GetName() == name {
+				readRequestSeqResp = &list.Data[i]
+				break
+			}
+		}
+		if readRequestSeqResp == nil {
+			resp.Diagnostics.AddError("Request Sequence not found", fmt.Sprintf("No request sequence named %q was found for resource %q.", name, data.ResourceId.ValueString()))
+			return
+		}
+	}
+
+	data.Id = types.StringValue(readRequestSeqResp.Id)
 	data.Link = types.StringValue(readRequestSeqResp.Link)
 	data.Description = types.StringValue(readRequestSeqResp.Description)
 	data.Name = types.StringValue(readRequestSeqResp.Name)
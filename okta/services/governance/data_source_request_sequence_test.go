@@ -29,3 +29,23 @@ func TestAccDataSourceOktaRequestSequence_read(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceOktaRequestSequence_byName(t *testing.T) {
+	mgr := newFixtureManager("data-sources", resources.OktaGovernanceRequestSequence, t.Name())
+	config := mgr.GetFixtures("datasource_by_name.tf", t)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okta_request_sequence.test", "id", "685d6e0fe2fccdd9801704b4"),
+					resource.TestCheckResourceAttr("data.okta_request_sequence.test", "compatible_resource_types.0", "APP"),
+				),
+			},
+		},
+	})
+}
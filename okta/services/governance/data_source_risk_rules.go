@@ -0,0 +1,236 @@
+package governance
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+)
+
+var _ datasource.DataSource = (*riskRulesDataSource)(nil)
+
+func newRiskRulesDataSource() datasource.DataSource {
+	return &riskRulesDataSource{}
+}
+
+type riskRulesDataSource struct {
+	*config.Config
+}
+
+type riskRulesDataSourceModel struct {
+	Id        types.String              `tfsdk:"id"`
+	Filter    types.String              `tfsdk:"filter"`
+	RiskRules []riskRuleDataSourceModel `tfsdk:"risk_rules"`
+}
+
+type riskRuleDataSourceModel struct {
+	Id               types.String                      `tfsdk:"id"`
+	Name             types.String                      `tfsdk:"name"`
+	Description      types.String                      `tfsdk:"description"`
+	Notes            types.String                      `tfsdk:"notes"`
+	ResourceOrn      types.String                      `tfsdk:"resource_orn"`
+	Type             types.String                      `tfsdk:"type"`
+	Status           types.String                      `tfsdk:"status"`
+	Created          types.String                      `tfsdk:"created"`
+	CreatedBy        types.String                      `tfsdk:"created_by"`
+	LastUpdated      types.String                      `tfsdk:"last_updated"`
+	LastUpdatedBy    types.String                      `tfsdk:"last_updated_by"`
+	ConflictCriteria []riskRuleCriteriaDataSourceModel `tfsdk:"conflict_criteria"`
+}
+
+type riskRuleCriteriaDataSourceModel struct {
+	Name         types.String   `tfsdk:"name"`
+	Operation    types.String   `tfsdk:"operation"`
+	Entitlements []entitlements `tfsdk:"entitlements"`
+}
+
+func (d *riskRulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_risk_rules"
+}
+
+func (d *riskRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = dataSourceConfiguration(req, resp)
+}
+
+func (d *riskRulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "List separation of duties risk rules.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this data source.",
+			},
+			"filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter expression on the `resourceOrn` or `name` of the risk rules, e.g. `resourceOrn eq \"orn:okta:idp:00o1n8sbwArJ7OQRw406:apps:salesforce:0oafxqCAJWWGELFTYASJ\"`. The `eq` operator is supported for `resourceOrn`, `sw` and `co` for `name`. All risk rules are returned when not set.",
+			},
+			"risk_rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The risk rules.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the risk rule.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the risk rule.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the risk rule.",
+						},
+						"notes": schema.StringAttribute{
+							Computed:    true,
+							Description: "Additional information about the risk rule.",
+						},
+						"resource_orn": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ORN of the resource the risk rule applies to.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the risk rule.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the risk rule.",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ISO 8601 formatted date and time when the risk rule was created.",
+						},
+						"created_by": schema.StringAttribute{
+							Computed:    true,
+							Description: "The id of the Okta user who created the risk rule.",
+						},
+						"last_updated": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ISO 8601 formatted date and time when the risk rule was last updated.",
+						},
+						"last_updated_by": schema.StringAttribute{
+							Computed:    true,
+							Description: "The id of the Okta user who last updated the risk rule.",
+						},
+						"conflict_criteria": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The criteria of the risk rule.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed:    true,
+										Description: "The name of the criteria.",
+									},
+									"operation": schema.StringAttribute{
+										Computed:    true,
+										Description: "The operation of the criteria, `CONTAINS_ONE` or `CONTAINS_ALL`.",
+									},
+									"entitlements": schema.ListNestedAttribute{
+										Computed:    true,
+										Description: "The entitlements and their values matched by the criteria.",
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"id": schema.StringAttribute{
+													Computed:    true,
+													Description: "The entitlement ID.",
+												},
+												"values": schema.ListNestedAttribute{
+													Computed:    true,
+													Description: "The entitlement values.",
+													NestedObject: schema.NestedAttributeObject{
+														Attributes: map[string]schema.Attribute{
+															"id": schema.StringAttribute{
+																Computed:    true,
+																Description: "The entitlement value ID.",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *riskRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data riskRulesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.OktaGovernanceClient.OktaGovernanceSDKClient()
+	data.RiskRules = []riskRuleDataSourceModel{}
+	after := ""
+	for {
+		listReq := client.RiskRulesAPI.ListRiskRules(ctx).Limit(int32(utils.DefaultPaginationLimit))
+		if filter := data.Filter.ValueString(); filter != "" {
+			listReq = listReq.Filter(filter)
+		}
+		if after != "" {
+			listReq = listReq.After(after)
+		}
+		list, _, err := listReq.Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error listing Risk Rules",
+				"Could not list Risk Rules, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		for _, riskRule := range list.GetData() {
+			data.RiskRules = append(data.RiskRules, riskRuleToDataSourceModel(riskRule))
+		}
+		after = nextPageCursor(list.Links)
+		if after == "" {
+			break
+		}
+	}
+
+	data.Id = types.StringValue("risk_rules")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func riskRuleToDataSourceModel(riskRule governance.RiskRuleResponse) riskRuleDataSourceModel {
+	model := riskRuleDataSourceModel{
+		Id:            types.StringValue(riskRule.GetId()),
+		Name:          types.StringValue(riskRule.GetName()),
+		Description:   types.StringPointerValue(riskRule.Description),
+		Notes:         types.StringPointerValue(riskRule.Notes),
+		ResourceOrn:   types.StringNull(),
+		Type:          types.StringValue(riskRule.GetType()),
+		Status:        types.StringValue(riskRule.GetStatus()),
+		Created:       types.StringValue(riskRule.GetCreated().Format(time.RFC3339)),
+		CreatedBy:     types.StringValue(riskRule.GetCreatedBy()),
+		LastUpdated:   types.StringValue(riskRule.GetLastUpdated().Format(time.RFC3339)),
+		LastUpdatedBy: types.StringValue(riskRule.GetLastUpdatedBy()),
+	}
+	if resources := riskRule.GetResources(); len(resources) > 0 {
+		model.ResourceOrn = types.StringValue(resources[0].GetResourceOrn())
+	}
+	for _, c := range riskRule.ConflictCriteria.GetAnd() {
+		grants := newEntitlementGrants()
+		if c.Value != nil {
+			grants.addFull(c.Value.GetValue())
+		}
+		model.ConflictCriteria = append(model.ConflictCriteria, riskRuleCriteriaDataSourceModel{
+			Name:         types.StringValue(c.GetName()),
+			Operation:    types.StringValue(c.GetOperation()),
+			Entitlements: grants.blocks(),
+		})
+	}
+	return model
+}
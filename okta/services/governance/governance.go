@@ -1,12 +1,20 @@
 package governance
 
 import (
+	"context"
 	"fmt"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
 	"github.com/okta/terraform-provider-okta/okta/config"
 	"github.com/okta/terraform-provider-okta/okta/resources"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func FWProviderResources() []func() resource.Resource {
@@ -21,6 +29,14 @@ func FWProviderResources() []func() resource.Resource {
 		newRequestV2Resource,
 		newEndUserMyRequestsResource,
 		newEntitlementBundleResource,
+		newCollectionResource,
+		newCollectionResourcesResource,
+		newRiskRuleResource,
+		newResourceOwnerResource,
+		newLabelResource,
+		newLabelAssignmentResource,
+		newGrantResource,
+		newGovernanceDelegateResource,
 	}
 	// Wrap all resources with SafeResource for panic recovery
 	return resources.WrapResources(rawResources)
@@ -29,6 +45,7 @@ func FWProviderResources() []func() resource.Resource {
 func FWProviderDataSources() []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newCampaignDataSource,
+		newCampaignReportDataSource,
 		newReviewDataSource,
 		newEntitlementDataSource,
 		newPrincipalEntitlementsDataSource,
@@ -41,6 +58,13 @@ func FWProviderDataSources() []func() datasource.DataSource {
 		newCatalogEntryUserAccessRequestFieldsDataSource,
 		newEndUserMyRequestsDataSource,
 		newEntitlementBundleDataSource,
+		newCollectionDataSource,
+		newCollectionsDataSource,
+		newRiskRulesDataSource,
+		newPrincipalRiskAssessmentDataSource,
+		newLabelDataSource,
+		newGrantsDataSource,
+		newGovernanceDelegatesDataSource,
 	}
 }
 
@@ -77,3 +101,76 @@ func resourceConfiguration(req resource.ConfigureRequest, resp *resource.Configu
 
 	return p
 }
+
+// nextPageCursor returns the cursor of the next page of a governance list
+// response, an empty string on the last page.
+func nextPageCursor(links *governance.ListLinks) string {
+	if links == nil {
+		return ""
+	}
+	return linkPageCursor(links.Next)
+}
+
+// linkPageCursor returns the cursor of a next link, for the list responses
+// with their own links type, e.g. reviews.
+func linkPageCursor(next *governance.Link) string {
+	if next == nil {
+		return ""
+	}
+	u, err := url.Parse(next.Href)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("after")
+}
+
+// doGovernanceRequest calls a governance endpoint the governance SDK has no API
+// for, e.g. labels and resource owners, with the request executor of the v2
+// SDK. The response is decoded into v when v is not nil.
+func doGovernanceRequest(ctx context.Context, c *config.Config, method, path string, body, v interface{}) (*sdk.Response, error) {
+	re := c.OktaIDaaSClient.OktaSDKClientV2().CloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, v)
+}
+
+// governanceListPath returns the path of a page of a governance list endpoint.
+func governanceListPath(path, filter, after string) string {
+	query := url.Values{}
+	query.Set("limit", strconv.FormatInt(utils.DefaultPaginationLimit, 10))
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+	if after != "" {
+		query.Set("after", after)
+	}
+	return path + "?" + query.Encode()
+}
+
+// parseRFC3339 returns the time of an optional RFC 3339 date and time, nil when
+// it is not set.
+func parseRFC3339(value types.String) (*time.Time, error) {
+	if value.ValueString() == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a date and time in RFC 3339 format", value.ValueString())
+	}
+	return &t, nil
+}
+
+// rfc3339Value returns the state value of an optional API date and time. The
+// prior value is kept when it is the same instant, the API may format it
+// differently than the configuration.
+func rfc3339Value(prior types.String, t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	if p, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && p.Equal(*t) {
+		return prior
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
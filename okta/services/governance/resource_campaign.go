@@ -2,9 +2,13 @@ package governance
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/cenkalti/backoff"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -14,16 +18,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/okta/okta-governance-sdk-golang/governance"
 	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/utils"
 )
 
 var (
@@ -61,6 +66,23 @@ type campaignResourceModel struct {
 	ScheduleSettings     *scheduleSettingsModel            `tfsdk:"schedule_settings"`
 	NotificationSettings *notificationSettingsModel        `tfsdk:"notification_settings"`
 	PrincipalScope       *principalScopeSettingsModel      `tfsdk:"principal_scope_settings"`
+	DesiredStatus        types.String                      `tfsdk:"desired_status"`
+	Status               types.String                      `tfsdk:"status"`
+	Progress             types.Object                      `tfsdk:"progress"`
+}
+
+type campaignProgressModel struct {
+	Total      types.Int64 `tfsdk:"total"`
+	Approved   types.Int64 `tfsdk:"approved"`
+	Revoked    types.Int64 `tfsdk:"revoked"`
+	Unreviewed types.Int64 `tfsdk:"unreviewed"`
+}
+
+var campaignProgressAttrTypes = map[string]attr.Type{
+	"total":      types.Int64Type,
+	"approved":   types.Int64Type,
+	"revoked":    types.Int64Type,
+	"unreviewed": types.Int64Type,
 }
 
 type campaignRemediationSettingsModel struct {
@@ -113,6 +135,7 @@ type resourceSettingsModel struct {
 	OnlyIncludeOutOfPolicyEntitlements types.Bool              `tfsdk:"only_include_out_of_policy_entitlements"`
 	ExcludedResources                  []excludedResourceModel `tfsdk:"excluded_resources"`
 	TargetResources                    []targetResourceModel   `tfsdk:"target_resources"`
+	TargetResourceLabelValueIds        types.Set               `tfsdk:"target_resource_label_value_ids"`
 }
 
 type excludedResourceModel struct {
@@ -194,14 +217,14 @@ func (r *campaignResource) Schema(ctx context.Context, req resource.SchemaReques
 				Required:    true,
 				Description: "Name of the campaign. Maintain some uniqueness when naming the campaign as it helps to identify and filter for campaigns when needed.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					campaignStringRequiresReplace(),
 				},
 			},
 			"campaign_tier": schema.StringAttribute{
 				Optional:    true,
 				Description: "Indicates the minimum required SKU to manage the campaign. Values can be `BASIC` and `PREMIUM`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					campaignStringRequiresReplace(),
 				},
 			},
 			"campaign_type": schema.StringAttribute{
@@ -212,28 +235,61 @@ func (r *campaignResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringvalidator.OneOf("RESOURCE", "USER"),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					campaignStringRequiresReplace(),
 				},
 			},
 			"description": schema.StringAttribute{
 				Optional:    true,
 				Description: "Description about the campaign.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					campaignStringRequiresReplace(),
 				},
 			},
 			"skip_remediation": schema.BoolAttribute{
 				Optional:    true,
-				Description: "If true, skip remediation when ending the campaign (only applicable if remediationSetting.noResponse=DENY).",
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
+				Description: "If true, skip remediation when ending the campaign (only applicable if remediationSetting.noResponse=DENY). Used when `desired_status` is `COMPLETED`.",
+			},
+			"desired_status": schema.StringAttribute{
+				Optional:    true,
+				Description: "The status the campaign is moved to: `READY` keeps the campaign waiting for its schedule, `ACTIVE` launches it and `COMPLETED` launches it if needed and ends it. Campaigns only move forward. When unset, the campaign follows its schedule.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(campaignDesiredStatusReady, campaignDesiredStatusActive, campaignDesiredStatusCompleted),
+				},
+				PlanModifiers: []planmodifier.String{
+					campaignDesiredStatusModifier{},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the campaign, e.g. `SCHEDULED`, `ACTIVE` or `COMPLETED`.",
+			},
+			"progress": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The number of reviews of the campaign by decision. Reviews are created when the campaign launches.",
+				Attributes: map[string]schema.Attribute{
+					"total": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The number of reviews.",
+					},
+					"approved": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The number of approved reviews.",
+					},
+					"revoked": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The number of revoked reviews.",
+					},
+					"unreviewed": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The number of reviews without a decision.",
+					},
 				},
 			},
 		},
 		Blocks: map[string]schema.Block{
 			"remediation_settings": schema.SingleNestedBlock{
 				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplace(),
+					campaignObjectRequiresReplace(),
 				},
 				Attributes: map[string]schema.Attribute{
 					"access_approved": schema.StringAttribute{
@@ -279,7 +335,7 @@ func (r *campaignResource) Schema(ctx context.Context, req resource.SchemaReques
 			},
 			"resource_settings": schema.SingleNestedBlock{
 				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplace(),
+					campaignObjectRequiresReplace(),
 				},
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
@@ -319,6 +375,11 @@ func (r *campaignResource) Schema(ctx context.Context, req resource.SchemaReques
 						Default:     booldefault.StaticBool(false),
 						Description: "Only include out-of-policy entitlements. Only applicable if resource_type = APPLICATION and Entitlement Management is enabled.",
 					},
+					"target_resource_label_value_ids": schema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "IDs of label values, see `okta_label`. The apps and groups, depending on `type`, labeled with any of the values are reviewed in addition to `target_resources`. The labeled resources are resolved when the campaign is created.",
+					},
 				},
 				Blocks: map[string]schema.Block{
 					"excluded_resources": schema.ListNestedBlock{
@@ -407,7 +468,7 @@ func (r *campaignResource) Schema(ctx context.Context, req resource.SchemaReques
 			},
 			"reviewer_settings": schema.SingleNestedBlock{
 				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplace(),
+					campaignObjectRequiresReplace(),
 				},
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
@@ -526,7 +587,7 @@ func (r *campaignResource) Schema(ctx context.Context, req resource.SchemaReques
 			},
 			"schedule_settings": schema.SingleNestedBlock{
 				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplace(),
+					campaignObjectRequiresReplace(),
 				},
 				Attributes: map[string]schema.Attribute{
 					"start_date": schema.StringAttribute{
@@ -579,7 +640,7 @@ func (r *campaignResource) Schema(ctx context.Context, req resource.SchemaReques
 			},
 			"notification_settings": schema.SingleNestedBlock{
 				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplace(),
+					campaignObjectRequiresReplace(),
 				},
 				Attributes: map[string]schema.Attribute{
 					"notify_reviewer_at_campaign_end": schema.BoolAttribute{
@@ -608,14 +669,14 @@ func (r *campaignResource) Schema(ctx context.Context, req resource.SchemaReques
 						ElementType: types.Int64Type,
 						Description: "Specifies times (in seconds) to send reminders to reviewers before the campaign closes. Max 3 values. Example: [86400, 172800, 604800]",
 						PlanModifiers: []planmodifier.List{
-							listplanmodifier.RequiresReplace(),
+							campaignListRequiresReplace(),
 						},
 					},
 				},
 			},
 			"principal_scope_settings": schema.SingleNestedBlock{
 				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplace(),
+					campaignObjectRequiresReplace(),
 				},
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
@@ -685,7 +746,18 @@ func (r *campaignResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	campaign, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().CampaignsAPI.CreateCampaign(ctx).CampaignMutable(buildCampaign(data)).Execute()
+	body := buildCampaign(data)
+	labeledTargets, err := labeledTargetResources(ctx, r.Config, data.ResourceSettings)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Campaign",
+			"Could not list the labeled target resources of the Campaign, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	body.ResourceSettings.TargetResources = append(body.ResourceSettings.TargetResources, labeledTargets...)
+
+	campaign, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().CampaignsAPI.CreateCampaign(ctx).CampaignMutable(body).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Campaign",
@@ -695,8 +767,27 @@ func (r *campaignResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	data.Id = types.StringValue(campaign.Id)
 
-	resp.Diagnostics.Append(applyCampaignsToState(ctx, campaign, &data)...)
-	if resp.Diagnostics.HasError() {
+	moved, err := r.moveCampaignToDesiredStatus(ctx, campaign, data)
+	if err != nil {
+		// the campaign was created and may have launched, an error would taint
+		// it although launched campaigns cannot be deleted. It is saved with
+		// its actual status and without desired_status, so the next apply
+		// retries the status change in place.
+		resp.Diagnostics.AddWarning(
+			"Campaign not moved to desired status",
+			"Campaign "+campaign.GetId()+" was created but could not be moved to status "+data.DesiredStatus.ValueString()+", the next apply retries. Unexpected error: "+err.Error(),
+		)
+		data.DesiredStatus = types.StringNull()
+		if current, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().CampaignsAPI.GetCampaign(ctx, campaign.GetId()).Execute(); err == nil {
+			moved = current
+		}
+	}
+	campaign = moved
+
+	diags := applyCampaignsToState(ctx, campaign, &data)
+	diags.Append(r.applyCampaignProgressToState(ctx, campaign, &data)...)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
 		return
 	}
 
@@ -722,6 +813,7 @@ func (r *campaignResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	resp.Diagnostics.Append(applyCampaignsToState(ctx, getCampaignResponse, &data)...)
+	resp.Diagnostics.Append(r.applyCampaignProgressToState(ctx, getCampaignResponse, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -744,11 +836,34 @@ func (r *campaignResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"The okta_campaign resource does not support in-place updates. All attributes require replacement. "+
-			"If you see this error, please destroy the resource and re-create it again.",
-	)
+	// The API has no update operation for campaigns, the plan modifiers replace
+	// the campaign when its settings change. Only desired_status and
+	// skip_remediation, which drive the launch and end operations, are updated
+	// in place.
+	campaign, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().CampaignsAPI.GetCampaign(ctx, state.Id.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Campaign",
+			"Could not read Campaign with ID "+state.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	campaign, err = r.moveCampaignToDesiredStatus(ctx, campaign, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Campaign",
+			"Could not move Campaign "+state.Id.ValueString()+" to status "+data.DesiredStatus.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(applyCampaignsToState(ctx, campaign, &data)...)
+	resp.Diagnostics.Append(r.applyCampaignProgressToState(ctx, campaign, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *campaignResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -760,6 +875,13 @@ func (r *campaignResource) Delete(ctx context.Context, req resource.DeleteReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !isCampaignReplaceable(data.Status.ValueString()) {
+		resp.Diagnostics.AddWarning(
+			"Campaign not deleted",
+			"Campaign "+data.Id.ValueString()+" is "+data.Status.ValueString()+", only SCHEDULED or ERROR campaigns can be deleted. It was removed from the Terraform state but still exists in Okta.",
+		)
+		return
+	}
 	// Delete API call logic
 	_, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().CampaignsAPI.DeleteCampaign(ctx, data.Id.ValueString()).Execute()
 	if err != nil {
@@ -777,6 +899,7 @@ func applyCampaignsToState(ctx context.Context, resp *governance.CampaignFull, c
 	c.Name = types.StringValue(resp.GetName())
 	c.CampaignType = types.StringValue(string(resp.GetCampaignType()))
 	c.Description = types.StringValue(resp.GetDescription())
+	c.Status = types.StringValue(string(resp.GetStatus()))
 
 	c.RemediationSettings = &campaignRemediationSettingsModel{}
 	if resp.RemediationSettings.GetAccessRevoked() != "" {
@@ -800,13 +923,26 @@ func applyCampaignsToState(ctx context.Context, resp *governance.CampaignFull, c
 		}
 	}
 
-	c.ResourceSettings = &resourceSettingsModel{}
+	// the label value IDs are not returned, the resources they resolved to are
+	// part of the target resources of the response
+	labelValueIDs := types.SetNull(types.StringType)
+	var configuredTargets []targetResourceModel
+	if c.ResourceSettings != nil {
+		labelValueIDs = c.ResourceSettings.TargetResourceLabelValueIds
+		configuredTargets = c.ResourceSettings.TargetResources
+	}
+	c.ResourceSettings = &resourceSettingsModel{TargetResourceLabelValueIds: labelValueIDs}
 	if resp.ResourceSettings.GetType() != "" {
 		c.ResourceSettings.Type = types.StringValue(string(resp.ResourceSettings.GetType()))
 	}
 	if len(resp.ResourceSettings.GetTargetResources()) > 0 {
 		var targets []targetResourceModel
 		for _, targetResource := range resp.ResourceSettings.GetTargetResources() {
+			if len(labelValueIDs.Elements()) > 0 && !slices.ContainsFunc(configuredTargets, func(t targetResourceModel) bool {
+				return t.ResourceId.ValueString() == targetResource.GetResourceId()
+			}) {
+				continue
+			}
 			target := targetResourceModel{
 				ResourceId: types.StringValue(targetResource.GetResourceId()),
 			}
@@ -1284,6 +1420,62 @@ func buildTargetResources(d campaignResourceModel) []governance.TargetResourcesR
 	return targetResources
 }
 
+// labeledTargetResources returns the apps and groups, depending on the type of
+// the resource settings, labeled with the target label values, skipping the
+// configured target resources.
+func labeledTargetResources(ctx context.Context, c *config.Config, settings *resourceSettingsModel) ([]governance.TargetResourcesRequestInner, error) {
+	if settings == nil || len(settings.TargetResourceLabelValueIds.Elements()) == 0 {
+		return nil, nil
+	}
+	var labelValueIDs []string
+	if diags := settings.TargetResourceLabelValueIds.ElementsAs(ctx, &labelValueIDs, false); diags.HasError() {
+		return nil, fmt.Errorf("invalid target_resource_label_value_ids")
+	}
+	slices.Sort(labelValueIDs)
+
+	resourceTypes := map[string]governance.ResourceType{
+		"apps":   governance.RESOURCETYPE_APPLICATION,
+		"groups": governance.RESOURCETYPE_GROUP,
+	}
+	switch settings.Type.ValueString() {
+	case "APPLICATION":
+		delete(resourceTypes, "groups")
+	case "GROUP":
+		delete(resourceTypes, "apps")
+	}
+
+	seen := map[string]bool{}
+	for _, target := range settings.TargetResources {
+		seen[target.ResourceId.ValueString()] = true
+	}
+	var targets []governance.TargetResourcesRequestInner
+	for _, labelValueID := range labelValueIDs {
+		for _, ornType := range []string{"apps", "groups"} {
+			resourceType, ok := resourceTypes[ornType]
+			if !ok {
+				continue
+			}
+			labeled, err := listLabeledResources(ctx, c, fmt.Sprintf("labelValueId eq %q AND resourceType eq %q", labelValueID, ornType))
+			if err != nil {
+				return nil, err
+			}
+			for _, res := range labeled {
+				orn := res.GetOrn()
+				id := orn[strings.LastIndex(orn, ":")+1:]
+				if seen[id] {
+					continue
+				}
+				seen[id] = true
+				targets = append(targets, governance.TargetResourcesRequestInner{
+					ResourceId:   id,
+					ResourceType: &resourceType,
+				})
+			}
+		}
+	}
+	return targets, nil
+}
+
 func getEntitlementValue(entitlements []entitlementValueModel) []governance.EntitlementValue {
 	var value []governance.EntitlementValue
 	for _, e := range entitlements {
@@ -1371,3 +1563,210 @@ func toNullableBool(v *bool) *governance.NullableBool {
 	}
 	return governance.NewNullableBool(v)
 }
+
+const (
+	campaignDesiredStatusReady     = "READY"
+	campaignDesiredStatusActive    = "ACTIVE"
+	campaignDesiredStatusCompleted = "COMPLETED"
+
+	campaignRequiresReplaceDescription = "Replaces the campaign while it is SCHEDULED, the API has no update operation for campaigns."
+)
+
+// campaignStatusRank orders the desired statuses, campaigns only move forward.
+var campaignStatusRank = map[string]int{
+	campaignDesiredStatusReady:                  0,
+	string(governance.CAMPAIGNSTATUS_SCHEDULED): 0,
+	string(governance.CAMPAIGNSTATUS_ERROR):     0,
+	string(governance.CAMPAIGNSTATUS_LAUNCHING): 1,
+	campaignDesiredStatusActive:                 1,
+	campaignDesiredStatusCompleted:              2,
+}
+
+// isCampaignReplaceable reports whether the campaign can be deleted, and thus
+// replaced: only campaigns that have not launched can be.
+func isCampaignReplaceable(status string) bool {
+	return status == "" || campaignStatusRank[status] == 0
+}
+
+// campaignRequiresReplace replaces the campaign when it has not launched and
+// fails the plan otherwise, as launched campaigns can neither be updated nor
+// deleted.
+func campaignRequiresReplace(ctx context.Context, state tfsdk.State, p path.Path, diags *diag.Diagnostics) bool {
+	var status types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("status"), &status)...)
+	if isCampaignReplaceable(status.ValueString()) {
+		return true
+	}
+	diags.AddAttributeError(
+		p,
+		"Campaign cannot be changed",
+		fmt.Sprintf("The campaign is %s, only SCHEDULED campaigns can be changed: the API has no update operation for campaigns and deletes SCHEDULED or ERROR campaigns only.", status.ValueString()),
+	)
+	return false
+}
+
+func campaignStringRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		resp.RequiresReplace = campaignRequiresReplace(ctx, req.State, req.Path, &resp.Diagnostics)
+	}, campaignRequiresReplaceDescription, campaignRequiresReplaceDescription)
+}
+
+func campaignObjectRequiresReplace() planmodifier.Object {
+	return objectplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+		resp.RequiresReplace = campaignRequiresReplace(ctx, req.State, req.Path, &resp.Diagnostics)
+	}, campaignRequiresReplaceDescription, campaignRequiresReplaceDescription)
+}
+
+func campaignListRequiresReplace() planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+		resp.RequiresReplace = campaignRequiresReplace(ctx, req.State, req.Path, &resp.Diagnostics)
+	}, campaignRequiresReplaceDescription, campaignRequiresReplaceDescription)
+}
+
+// campaignDesiredStatusModifier fails the plan when desired_status moves the
+// campaign backward, e.g. from ACTIVE to READY.
+type campaignDesiredStatusModifier struct{}
+
+func (m campaignDesiredStatusModifier) Description(ctx context.Context) string {
+	return "Campaigns only move forward, from READY to ACTIVE to COMPLETED."
+}
+
+func (m campaignDesiredStatusModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m campaignDesiredStatusModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() || req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+	var status types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("status"), &status)...)
+	current, ok := campaignStatusRank[status.ValueString()]
+	if ok && campaignStatusRank[req.PlanValue.ValueString()] < current {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid desired status",
+			fmt.Sprintf("The campaign is %s and cannot move back to %s.", status.ValueString(), req.PlanValue.ValueString()),
+		)
+	}
+}
+
+// moveCampaignToDesiredStatus launches and ends the campaign until it reaches
+// the desired status. It returns the last known campaign, also on error.
+func (r *campaignResource) moveCampaignToDesiredStatus(ctx context.Context, campaign *governance.CampaignFull, data campaignResourceModel) (*governance.CampaignFull, error) {
+	client := r.OktaGovernanceClient.OktaGovernanceSDKClient()
+	desired := campaignStatusRank[data.DesiredStatus.ValueString()]
+	if desired >= campaignStatusRank[campaignDesiredStatusActive] && isCampaignReplaceable(string(campaign.GetStatus())) {
+		_, err := client.CampaignsAPI.LaunchCampaign(ctx, campaign.GetId()).Execute()
+		if err != nil {
+			return campaign, err
+		}
+		launched, err := r.waitForCampaignLaunch(ctx, campaign.GetId())
+		if err != nil {
+			return campaign, err
+		}
+		campaign = launched
+	}
+	if desired == campaignStatusRank[campaignDesiredStatusCompleted] && campaign.GetStatus() == governance.CAMPAIGNSTATUS_ACTIVE {
+		endReq := client.CampaignsAPI.EndCampaign(ctx, campaign.GetId())
+		if !data.SkipRemediation.IsNull() {
+			endReq = endReq.CampaignEndSkipRemediation(governance.CampaignEndSkipRemediation{SkipRemediation: data.SkipRemediation.ValueBoolPointer()})
+		}
+		_, err := endReq.Execute()
+		if err != nil {
+			return campaign, err
+		}
+		ended, _, err := client.CampaignsAPI.GetCampaign(ctx, campaign.GetId()).Execute()
+		if err != nil {
+			return campaign, err
+		}
+		campaign = ended
+	}
+	return campaign, nil
+}
+
+// waitForCampaignLaunch waits for the asynchronous launch of the campaign to
+// finish.
+func (r *campaignResource) waitForCampaignLaunch(ctx context.Context, campaignID string) (*governance.CampaignFull, error) {
+	var campaign *governance.CampaignFull
+	boc := utils.NewExponentialBackOffWithContext(ctx, 5*time.Minute)
+	err := backoff.Retry(func() error {
+		var err error
+		campaign, _, err = r.OktaGovernanceClient.OktaGovernanceSDKClient().CampaignsAPI.GetCampaign(ctx, campaignID).Execute()
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		switch campaign.GetStatus() {
+		case governance.CAMPAIGNSTATUS_ERROR:
+			return backoff.Permanent(fmt.Errorf("the launch of campaign %s failed", campaignID))
+		case governance.CAMPAIGNSTATUS_ACTIVE, governance.CAMPAIGNSTATUS_COMPLETED:
+			return nil
+		}
+		return fmt.Errorf("campaign %s is still %s", campaignID, campaign.GetStatus())
+	}, boc)
+	return campaign, err
+}
+
+// applyCampaignProgressToState counts the reviews of the campaign by decision.
+// Reviews are only created when the campaign launches, the reviews of a
+// campaign that has not launched are not listed.
+func (r *campaignResource) applyCampaignProgressToState(ctx context.Context, campaign *governance.CampaignFull, c *campaignResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var total, approved, revoked, unreviewed int64
+	if !isCampaignReplaceable(string(campaign.GetStatus())) {
+		err := forEachCampaignReview(ctx, r.Config, campaign.GetId(), func(review *governance.ReviewSparse) error {
+			total++
+			switch review.GetDecision() {
+			case governance.DECISION_APPROVE:
+				approved++
+			case governance.DECISION_REVOKE:
+				revoked++
+			default:
+				unreviewed++
+			}
+			return nil
+		})
+		if err != nil {
+			diags.AddError(
+				"Error reading Campaign progress",
+				"Could not list the reviews of Campaign "+campaign.GetId()+", unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+	c.Progress, diags = types.ObjectValueFrom(ctx, campaignProgressAttrTypes, campaignProgressModel{
+		Total:      types.Int64Value(total),
+		Approved:   types.Int64Value(approved),
+		Revoked:    types.Int64Value(revoked),
+		Unreviewed: types.Int64Value(unreviewed),
+	})
+	return diags
+}
+
+// forEachCampaignReview calls fn with every review of the campaign, one page
+// of reviews at a time, so large campaigns are never held in memory at once.
+func forEachCampaignReview(ctx context.Context, c *config.Config, campaignID string, fn func(*governance.ReviewSparse) error) error {
+	client := c.OktaGovernanceClient.OktaGovernanceSDKClient()
+	after := ""
+	for {
+		listReq := client.ReviewsAPI.ListReviews(ctx).
+			Filter(fmt.Sprintf("campaignId eq %q", campaignID)).
+			Limit(int32(utils.DefaultPaginationLimit))
+		if after != "" {
+			listReq = listReq.After(after)
+		}
+		list, _, err := listReq.Execute()
+		if err != nil {
+			return err
+		}
+		for i := range list.Data {
+			if err := fn(&list.Data[i]); err != nil {
+				return err
+			}
+		}
+		after = linkPageCursor(list.Links.Next)
+		if after == "" {
+			return nil
+		}
+	}
+}
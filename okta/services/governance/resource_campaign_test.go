@@ -85,3 +85,50 @@ func TestAccCampaignResource_requiresReplace(t *testing.T) {
 		},
 	})
 }
+
+// TestAccCampaignResource_lifecycle verifies that desired_status launches and
+// ends the campaign in place and that the progress of the reviews is read.
+func TestAccCampaignResource_lifecycle(t *testing.T) {
+	mgr := newFixtureManager("resources", resources.OktaGovernanceCampaign, t.Name())
+	config := mgr.GetFixtures("lifecycle.tf", t)
+	activeConfig := mgr.GetFixtures("lifecycle_active.tf", t)
+	completedConfig := mgr.GetFixtures("lifecycle_completed.tf", t)
+	resourceName := fmt.Sprintf("%s.test", resources.OktaGovernanceCampaign)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "desired_status", "READY"),
+					resource.TestCheckResourceAttr(resourceName, "status", "SCHEDULED"),
+					resource.TestCheckResourceAttr(resourceName, "progress.total", "0"),
+				),
+			},
+			{
+				Config: activeConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "desired_status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "progress.total", "2"),
+					resource.TestCheckResourceAttr(resourceName, "progress.unreviewed", "2"),
+				),
+			},
+			{
+				Config: completedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "desired_status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "progress.total", "2"),
+					resource.TestCheckResourceAttr(resourceName, "progress.approved", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progress.revoked", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progress.unreviewed", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,220 @@
+package governance
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+var (
+	_ resource.Resource                = &collectionResource{}
+	_ resource.ResourceWithConfigure   = &collectionResource{}
+	_ resource.ResourceWithImportState = &collectionResource{}
+)
+
+func newCollectionResource() resource.Resource {
+	return &collectionResource{}
+}
+
+type collectionResource struct {
+	*config.Config
+}
+
+type collectionResourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Description              types.String `tfsdk:"description"`
+	Created                  types.String `tfsdk:"created"`
+	CreatedBy                types.String `tfsdk:"created_by"`
+	LastUpdated              types.String `tfsdk:"last_updated"`
+	LastUpdatedBy            types.String `tfsdk:"last_updated_by"`
+	PrincipalAssignmentCount types.Int64  `tfsdk:"principal_assignment_count"`
+	ApplicationCount         types.Int64  `tfsdk:"application_count"`
+}
+
+func (r *collectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection"
+}
+
+func (r *collectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *collectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *collectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a resource collection. Use `okta_collection_resources` to manage the resources in the collection.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the collection",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the collection",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the collection",
+				Optional:    true,
+			},
+			"created": schema.StringAttribute{
+				Description: "The ISO 8601 formatted date and time when the collection was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Description: "The id of the Okta user who created the collection.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The ISO 8601 formatted date and time when the collection was last updated.",
+				Computed:    true,
+			},
+			"last_updated_by": schema.StringAttribute{
+				Description: "The id of the Okta user who last updated the collection.",
+				Computed:    true,
+			},
+			"principal_assignment_count": schema.Int64Attribute{
+				Description: "Number of principals the collection is assigned to.",
+				Computed:    true,
+			},
+			"application_count": schema.Int64Attribute{
+				Description: "Number of applications in the collection.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *collectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data collectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	collection, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().CollectionsAPI.CreateCollection(ctx).CollectionCreatable(governance.CollectionCreatable{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Collection",
+			"Could not create Collection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	applyCollectionToState(collection, &data)
+
+	// Save Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *collectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data collectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	collection, httpResp, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().CollectionsAPI.GetCollection(ctx, data.Id.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading Collection",
+			"Could not read Collection with ID "+data.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	applyCollectionToState(collection, &data)
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *collectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data collectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic
+	collection, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().CollectionsAPI.ReplaceCollection(ctx, data.Id.ValueString()).CollectionUpdatable(governance.CollectionUpdatable{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Collection",
+			"Could not update Collection with ID "+data.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	applyCollectionToState(collection, &data)
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *collectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data collectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	httpResp, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().CollectionsAPI.DeleteCollection(ctx, data.Id.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting Collection",
+			"Could not delete Collection with ID "+data.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func applyCollectionToState(collection *governance.CollectionFull, data *collectionResourceModel) {
+	data.Id = types.StringValue(collection.GetId())
+	data.Name = types.StringValue(collection.GetName())
+	data.Description = types.StringPointerValue(collection.Description)
+	data.Created = types.StringValue(collection.GetCreated().Format(time.RFC3339))
+	data.CreatedBy = types.StringValue(collection.GetCreatedBy())
+	data.LastUpdated = types.StringValue(collection.GetLastUpdated().Format(time.RFC3339))
+	data.LastUpdatedBy = types.StringValue(collection.GetLastUpdatedBy())
+	data.PrincipalAssignmentCount = types.Int64Null()
+	data.ApplicationCount = types.Int64Null()
+	if counts, ok := collection.GetCountsOk(); ok {
+		data.PrincipalAssignmentCount = types.Int64Value(int64(counts.GetPrincipalAssignmentCount()))
+		data.ApplicationCount = types.Int64Value(int64(counts.ResourceCounts.GetApplications()))
+	}
+}
@@ -0,0 +1,351 @@
+package governance
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+)
+
+var (
+	_ resource.Resource                = &collectionResourcesResource{}
+	_ resource.ResourceWithConfigure   = &collectionResourcesResource{}
+	_ resource.ResourceWithImportState = &collectionResourcesResource{}
+)
+
+func newCollectionResourcesResource() resource.Resource {
+	return &collectionResourcesResource{}
+}
+
+type collectionResourcesResource struct {
+	*config.Config
+}
+
+type collectionResourcesResourceModel struct {
+	Id           types.String              `tfsdk:"id"`
+	CollectionId types.String              `tfsdk:"collection_id"`
+	Resources    []collectionResourceBlock `tfsdk:"resource"`
+}
+
+type collectionResourceBlock struct {
+	ResourceOrn  types.String   `tfsdk:"resource_orn"`
+	Entitlements []entitlements `tfsdk:"entitlements"`
+}
+
+func (r *collectionResourcesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection_resources"
+}
+
+func (r *collectionResourcesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *collectionResourcesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection_id"), req.ID)...)
+}
+
+func (r *collectionResourcesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the resources of a resource collection. This resource is authoritative: resources added to the collection outside of Terraform are removed on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the collection.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection_id": schema.StringAttribute{
+				Description: "The ID of the collection.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_orn": schema.StringAttribute{
+							Description: "The ORN of the resource. Only applications are supported.",
+							Required:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"entitlements": schema.SetNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "Entitlement ID",
+										Required:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"values": schema.SetNestedBlock{
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"id": schema.StringAttribute{
+													Description: "Entitlement value ID",
+													Required:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+							Description: "Entitlements and their values of the resource included in the collection.",
+						},
+					},
+				},
+				Description: "A resource of the collection.",
+			},
+		},
+	}
+}
+
+func (r *collectionResourcesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data collectionResourcesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	if len(data.Resources) > 0 {
+		_, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().CollectionsAPI.AddResourcesToCollection(ctx, data.CollectionId.ValueString()).CollectionResourceCreatable(buildCollectionResourcesBody(data.Resources)).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error adding resources to Collection",
+				"Could not add resources to Collection with ID "+data.CollectionId.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	data.Id = data.CollectionId
+
+	// Save Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *collectionResourcesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data collectionResourcesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	collectionResources, httpResp, err := listCollectionResources(ctx, r.OktaGovernanceClient.OktaGovernanceSDKClient(), data.CollectionId.ValueString())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading Collection resources",
+			"Could not read resources of Collection with ID "+data.CollectionId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Id = data.CollectionId
+	data.Resources = nil
+	for _, collectionResource := range collectionResources {
+		data.Resources = append(data.Resources, collectionResourceToBlock(collectionResource))
+	}
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *collectionResourcesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state collectionResourcesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.OktaGovernanceClient.OktaGovernanceSDKClient()
+	collectionID := data.CollectionId.ValueString()
+	current := make(map[string]collectionResourceBlock, len(state.Resources))
+	for _, block := range state.Resources {
+		current[block.ResourceOrn.ValueString()] = block
+	}
+	planned := make(map[string]bool, len(data.Resources))
+	var added []collectionResourceBlock
+	for _, block := range data.Resources {
+		orn := block.ResourceOrn.ValueString()
+		planned[orn] = true
+		prior, ok := current[orn]
+		if !ok {
+			added = append(added, block)
+			continue
+		}
+		if collectionEntitlementsKey(prior.Entitlements) == collectionEntitlementsKey(block.Entitlements) {
+			continue
+		}
+		_, _, err := client.CollectionsAPI.ReplaceCollectionResource(ctx, collectionID, orn).CollectionResourceUpdatable(governance.CollectionResourceUpdatable{
+			Entitlements: buildCollectionEntitlements(block.Entitlements),
+		}).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Collection resource",
+				"Could not update resource "+orn+" of Collection with ID "+collectionID+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	for orn := range current {
+		if planned[orn] {
+			continue
+		}
+		httpResp, err := client.CollectionsAPI.DeleteCollectionResource(ctx, collectionID, orn).Execute()
+		if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+			resp.Diagnostics.AddError(
+				"Error removing Collection resource",
+				"Could not remove resource "+orn+" from Collection with ID "+collectionID+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if len(added) > 0 {
+		_, _, err := client.CollectionsAPI.AddResourcesToCollection(ctx, collectionID).CollectionResourceCreatable(buildCollectionResourcesBody(added)).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error adding resources to Collection",
+				"Could not add resources to Collection with ID "+collectionID+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	data.Id = data.CollectionId
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *collectionResourcesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data collectionResourcesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	client := r.OktaGovernanceClient.OktaGovernanceSDKClient()
+	for _, block := range data.Resources {
+		orn := block.ResourceOrn.ValueString()
+		httpResp, err := client.CollectionsAPI.DeleteCollectionResource(ctx, data.CollectionId.ValueString(), orn).Execute()
+		if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+			resp.Diagnostics.AddError(
+				"Error removing Collection resource",
+				"Could not remove resource "+orn+" from Collection with ID "+data.CollectionId.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// listCollectionResources returns all the resources of a collection, following
+// the pagination cursor.
+func listCollectionResources(ctx context.Context, client *governance.OktaGovernanceAPIClient, collectionID string) ([]governance.CollectionResourceFull, *governance.APIResponse, error) {
+	var all []governance.CollectionResourceFull
+	after := ""
+	for {
+		listReq := client.CollectionsAPI.ListCollectionResources(ctx, collectionID).Limit(int32(utils.DefaultPaginationLimit))
+		if after != "" {
+			listReq = listReq.After(after)
+		}
+		list, httpResp, err := listReq.Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		all = append(all, list.GetData()...)
+		after = nextPageCursor(list.Links)
+		if after == "" {
+			return all, httpResp, nil
+		}
+	}
+}
+
+func buildCollectionResourcesBody(blocks []collectionResourceBlock) []governance.CollectionResourceCreatable {
+	body := make([]governance.CollectionResourceCreatable, 0, len(blocks))
+	for _, block := range blocks {
+		body = append(body, governance.CollectionResourceCreatable{
+			ResourceOrn:  block.ResourceOrn.ValueString(),
+			Entitlements: buildCollectionEntitlements(block.Entitlements),
+		})
+	}
+	return body
+}
+
+func buildCollectionEntitlements(ents []entitlements) []governance.EntitlementCreatable {
+	if len(ents) == 0 {
+		return nil
+	}
+	result := make([]governance.EntitlementCreatable, 0, len(ents))
+	for _, ent := range ents {
+		values := make([]governance.EntitlementValueCreatable, 0, len(ent.Values))
+		for _, val := range ent.Values {
+			values = append(values, governance.EntitlementValueCreatable{
+				Id: val.Id.ValueStringPointer(),
+			})
+		}
+		result = append(result, governance.EntitlementCreatable{
+			Id:     ent.Id.ValueStringPointer(),
+			Values: values,
+		})
+	}
+	return result
+}
+
+func collectionResourceToBlock(collectionResource governance.CollectionResourceFull) collectionResourceBlock {
+	block := collectionResourceBlock{
+		ResourceOrn: types.StringValue(collectionResource.GetResourceOrn()),
+	}
+	for _, ent := range collectionResource.GetEntitlements() {
+		var vals []valueBlock
+		for _, v := range ent.GetValues() {
+			vals = append(vals, valueBlock{
+				Id: types.StringValue(v.GetId()),
+			})
+		}
+		block.Entitlements = append(block.Entitlements, entitlements{
+			Id:     types.StringValue(ent.GetId()),
+			Values: vals,
+		})
+	}
+	return block
+}
+
+// collectionEntitlementsKey returns a string identifying a set of entitlements
+// and their values regardless of their order.
+func collectionEntitlementsKey(ents []entitlements) string {
+	var keys []string
+	for _, ent := range ents {
+		keys = append(keys, ent.Id.ValueString()+":")
+		for _, val := range ent.Values {
+			keys = append(keys, ent.Id.ValueString()+":"+val.Id.ValueString())
+		}
+	}
+	slices.Sort(keys)
+	return strings.Join(keys, ",")
+}
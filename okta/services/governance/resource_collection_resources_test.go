@@ -0,0 +1,56 @@
+package governance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccCollectionResourcesResource_basic(t *testing.T) {
+	acctest.RequireSKU(t, config.SKUGovernance)
+	mgr := newFixtureManager("resources", resources.OktaGovernanceCollectionResources, t.Name())
+	tfConfig := mgr.GetFixtures("basic.tf", t)
+	updatedConfig := mgr.GetFixtures("updated.tf", t)
+	resourceName := fmt.Sprintf("%s.test", resources.OktaGovernanceCollectionResources)
+	orn := "orn:oktapreview:idp:00onkw1sbuAh3Q06I1d7:apps:oidc_client:0oao01ardu8r8qUP91d7"
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "collection_id", "okta_collection.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "resource.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource.*", map[string]string{
+						"resource_orn":   orn,
+						"entitlements.#": "0",
+					}),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resource.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource.*", map[string]string{
+						"resource_orn":               orn,
+						"entitlements.#":             "1",
+						"entitlements.0.id":          "espzcbqd7Suwp4Y7A1d6",
+						"entitlements.0.values.0.id": "entzcbqd8lcD3BRWR1d6",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
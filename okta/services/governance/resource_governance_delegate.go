@@ -0,0 +1,290 @@
+package governance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+const (
+	principalSettingsPath = "/governance/api/v1/principal-settings"
+	delegatesPath         = "/governance/api/v1/settings/delegates"
+)
+
+var (
+	_ resource.Resource                = &governanceDelegateResource{}
+	_ resource.ResourceWithConfigure   = &governanceDelegateResource{}
+	_ resource.ResourceWithImportState = &governanceDelegateResource{}
+)
+
+func newGovernanceDelegateResource() resource.Resource {
+	return &governanceDelegateResource{}
+}
+
+type governanceDelegateResource struct {
+	*config.Config
+}
+
+type governanceDelegateResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	PrincipalId   types.String `tfsdk:"principal_id"`
+	DelegateId    types.String `tfsdk:"delegate_id"`
+	StartTime     types.String `tfsdk:"start_time"`
+	EndTime       types.String `tfsdk:"end_time"`
+	Note          types.String `tfsdk:"note"`
+	AppointmentId types.String `tfsdk:"appointment_id"`
+}
+
+func (r *governanceDelegateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_governance_delegate"
+}
+
+func (r *governanceDelegateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *governanceDelegateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_id"), req.ID)...)
+}
+
+func (r *governanceDelegateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Appoints the delegate of a user. The delegate performs the governance duties of the user, e.g. access certification reviews and access request approvals, while the appointment is active. A user has at most one delegate.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the user delegating their duties.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"principal_id": schema.StringAttribute{
+				Description: "The ID of the user delegating their duties.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delegate_id": schema.StringAttribute{
+				Description: "The ID of the user appointed as delegate.",
+				Required:    true,
+			},
+			"start_time": schema.StringAttribute{
+				Description: "The date and time, in RFC 3339 format, when the appointment starts, e.g. `2026-07-01T00:00:00Z`. The appointment starts immediately when not set.",
+				Optional:    true,
+			},
+			"end_time": schema.StringAttribute{
+				Description: "The date and time, in RFC 3339 format, when the appointment ends. The appointment does not end when not set.",
+				Optional:    true,
+			},
+			"note": schema.StringAttribute{
+				Description: "A note that describes the appointment, e.g. the reason of the absence of the user.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1000),
+				},
+			},
+			"appointment_id": schema.StringAttribute{
+				Description: "The ID of the delegate appointment.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *governanceDelegateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data governanceDelegateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appointment, err := buildDelegatePatchable(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid delegate appointment", err.Error())
+		return
+	}
+
+	// Create API call logic
+	appointments, err := r.patchDelegates(ctx, data.PrincipalId.ValueString(), []governance.DelegatePatchable{*appointment})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Governance Delegate",
+			"Could not appoint the delegate of "+data.PrincipalId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if len(appointments) == 0 {
+		resp.Diagnostics.AddError(
+			"Error creating Governance Delegate",
+			"Could not find the delegate appointment of "+data.PrincipalId.ValueString()+" in the response.",
+		)
+		return
+	}
+	applyGovernanceDelegateToState(&appointments[0], &data)
+
+	// Save Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *governanceDelegateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data governanceDelegateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	appointments, err := listDelegateAppointments(ctx, r.Config, fmt.Sprintf("delegator.externalId eq %q", data.PrincipalId.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Governance Delegate",
+			"Could not read the delegate of "+data.PrincipalId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var appointment *governance.DelegateAppointment
+	for i := range appointments {
+		if appointments[i].Delegator.ExternalId == data.PrincipalId.ValueString() {
+			appointment = &appointments[i]
+			break
+		}
+	}
+	if appointment == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	applyGovernanceDelegateToState(appointment, &data)
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *governanceDelegateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data governanceDelegateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appointment, err := buildDelegatePatchable(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid delegate appointment", err.Error())
+		return
+	}
+
+	// Update API call logic
+	appointments, err := r.patchDelegates(ctx, data.PrincipalId.ValueString(), []governance.DelegatePatchable{*appointment})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Governance Delegate",
+			"Could not appoint the delegate of "+data.PrincipalId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if len(appointments) == 0 {
+		resp.Diagnostics.AddError(
+			"Error updating Governance Delegate",
+			"Could not find the delegate appointment of "+data.PrincipalId.ValueString()+" in the response.",
+		)
+		return
+	}
+	applyGovernanceDelegateToState(&appointments[0], &data)
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *governanceDelegateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data governanceDelegateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic, a user without appointments has no delegate
+	_, err := r.patchDelegates(ctx, data.PrincipalId.ValueString(), []governance.DelegatePatchable{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Governance Delegate",
+			"Could not remove the delegate of "+data.PrincipalId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// patchDelegates replaces the delegate appointments of the user.
+func (r *governanceDelegateResource) patchDelegates(ctx context.Context, principalID string, appointments []governance.DelegatePatchable) ([]governance.DelegateAppointment, error) {
+	body := governance.PrincipalSettingsPatchable{
+		Delegates: &governance.DelegatesPatchable{Appointments: appointments},
+	}
+	var settings governance.PrincipalSettings
+	_, err := doGovernanceRequest(ctx, r.Config, http.MethodPatch, principalSettingsPath+"/"+principalID, body, &settings)
+	if err != nil {
+		return nil, err
+	}
+	return settings.Delegates.GetAppointments(), nil
+}
+
+func buildDelegatePatchable(data governanceDelegateResourceModel) (*governance.DelegatePatchable, error) {
+	startTime, err := parseRFC3339(data.StartTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start_time: %w", err)
+	}
+	endTime, err := parseRFC3339(data.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end_time: %w", err)
+	}
+	if startTime != nil && endTime != nil && !endTime.After(*startTime) {
+		return nil, fmt.Errorf("end_time must be after start_time")
+	}
+	return &governance.DelegatePatchable{
+		Delegate: governance.DelegateAppointmentDelegate{
+			ExternalId: data.DelegateId.ValueString(),
+			Type:       governance.PRINCIPALTYPE_OKTA_USER,
+		},
+		Note:      data.Note.ValueStringPointer(),
+		StartTime: startTime,
+		EndTime:   endTime,
+	}, nil
+}
+
+// listDelegateAppointments returns all the delegate appointments matching the
+// filter, following the pagination cursor.
+func listDelegateAppointments(ctx context.Context, c *config.Config, filter string) ([]governance.DelegateAppointment, error) {
+	var all []governance.DelegateAppointment
+	after := ""
+	for {
+		var list governance.DelegateAppointmentList
+		_, err := doGovernanceRequest(ctx, c, http.MethodGet, governanceListPath(delegatesPath, filter, after), nil, &list)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list.GetData()...)
+		after = linkPageCursor(list.Links.Next)
+		if after == "" {
+			return all, nil
+		}
+	}
+}
+
+func applyGovernanceDelegateToState(appointment *governance.DelegateAppointment, data *governanceDelegateResourceModel) {
+	data.Id = data.PrincipalId
+	data.AppointmentId = types.StringValue(appointment.GetId())
+	data.DelegateId = types.StringValue(appointment.Delegate.GetExternalId())
+	data.StartTime = rfc3339Value(data.StartTime, appointment.StartTime)
+	data.EndTime = rfc3339Value(data.EndTime, appointment.EndTime)
+	data.Note = types.StringPointerValue(appointment.Note)
+}
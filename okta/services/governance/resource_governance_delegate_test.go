@@ -0,0 +1,52 @@
+package governance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccGovernanceDelegateResource_basic(t *testing.T) {
+	acctest.RequireSKU(t, config.SKUGovernance)
+	mgr := newFixtureManager("resources", resources.OktaGovernanceDelegate, t.Name())
+	tfConfig := mgr.GetFixtures("basic.tf", t)
+	updatedConfig := mgr.GetFixtures("updated.tf", t)
+	resourceName := fmt.Sprintf("%s.test", resources.OktaGovernanceDelegate)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "00unkw1sfbTw08c0g1d7"),
+					resource.TestCheckResourceAttr(resourceName, "delegate_id", "00uo0ntv2fYJkUwLO1d7"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", "2025-10-20T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "end_time", "2025-10-31T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "note", "Out of office"),
+					resource.TestCheckResourceAttrSet(resourceName, "appointment_id"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delegate_id", "00uo0ntv9pQzGmXbK1d7"),
+					resource.TestCheckNoResourceAttr(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "end_time", "2025-11-07T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "note", "Parental leave"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,405 @@
+package governance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// grantsPath is called through doGovernanceRequest: the SDK fails to decode
+// grants, they match both schemas of the oneOf responses of the grants API.
+const grantsPath = "/governance/api/v1/grants"
+
+var (
+	_ resource.Resource                   = &grantResource{}
+	_ resource.ResourceWithConfigure      = &grantResource{}
+	_ resource.ResourceWithImportState    = &grantResource{}
+	_ resource.ResourceWithValidateConfig = &grantResource{}
+)
+
+func newGrantResource() resource.Resource {
+	return &grantResource{}
+}
+
+type grantResource struct {
+	*config.Config
+}
+
+type grantResourceModel struct {
+	Id                  types.String   `tfsdk:"id"`
+	PrincipalId         types.String   `tfsdk:"principal_id"`
+	PrincipalOrn        types.String   `tfsdk:"principal_orn"`
+	ResourceOrn         types.String   `tfsdk:"resource_orn"`
+	EntitlementBundleId types.String   `tfsdk:"entitlement_bundle_id"`
+	ScheduledRevocation types.String   `tfsdk:"scheduled_revocation"`
+	GrantType           types.String   `tfsdk:"grant_type"`
+	Status              types.String   `tfsdk:"status"`
+	Entitlements        []entitlements `tfsdk:"entitlements"`
+}
+
+func (r *grantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grant"
+}
+
+func (r *grantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *grantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *grantResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grants entitlement values or an entitlement bundle of a resource to a user. Destroying the grant revokes it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the grant.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"principal_id": schema.StringAttribute{
+				Description: "The ID of the user the access is granted to. The grants API only supports users as principals.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_orn": schema.StringAttribute{
+				Description: "The ORN of the user the access is granted to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_orn": schema.StringAttribute{
+				Description: "The ORN of the app the entitlements belong to. Required with `entitlements`, defaults to the app of the bundle with `entitlement_bundle_id`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entitlement_bundle_id": schema.StringAttribute{
+				Description: "The ID of the entitlement bundle to grant. Conflicts with `entitlements`.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scheduled_revocation": schema.StringAttribute{
+				Description: "The date and time, in RFC 3339 format, when the grant is revoked, e.g. `2026-12-31T23:59:59Z`. The grant does not expire when not set.",
+				Optional:    true,
+			},
+			"grant_type": schema.StringAttribute{
+				Description: "The type of the grant, `CUSTOM` for entitlements or `ENTITLEMENT-BUNDLE`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the grant.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"entitlements": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Entitlement ID",
+							Required:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"values": schema.SetNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "Entitlement value ID",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+				Description: "The entitlements and values to grant. Conflicts with `entitlement_bundle_id`.",
+			},
+		},
+	}
+}
+
+func (r *grantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var bundleID, resourceOrn types.String
+	var ents types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entitlement_bundle_id"), &bundleID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("resource_orn"), &resourceOrn)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entitlements"), &ents)...)
+	if resp.Diagnostics.HasError() || bundleID.IsUnknown() || ents.IsUnknown() {
+		return
+	}
+	hasEntitlements := len(ents.Elements()) > 0
+	if bundleID.IsNull() == !hasEntitlements {
+		resp.Diagnostics.AddError("Invalid configuration", "Exactly one of `entitlement_bundle_id` or `entitlements` must be set.")
+		return
+	}
+	if hasEntitlements && resourceOrn.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("resource_orn"), "Missing resource ORN", "`resource_orn` must be set to grant `entitlements`.")
+	}
+}
+
+func (r *grantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data grantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleSettings, err := grantScheduleSettings(data.ScheduledRevocation)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("scheduled_revocation"), "Invalid scheduled revocation", err.Error())
+		return
+	}
+	principal := governance.TargetPrincipal{
+		ExternalId: data.PrincipalId.ValueString(),
+		Type:       governance.PRINCIPALTYPE_OKTA_USER,
+	}
+	var body governance.GrantCreatable
+	if bundleID := data.EntitlementBundleId.ValueString(); bundleID != "" {
+		body.GrantTypeBundleWriteable = &governance.GrantTypeBundleWriteable{
+			GrantType:           string(governance.GRANTTYPE_ENTITLEMENT_BUNDLE),
+			EntitlementBundleId: bundleID,
+			TargetPrincipal:     principal,
+			ScheduleSettings:    scheduleSettings,
+		}
+	} else {
+		appID, err := appIDFromResourceOrn(data.ResourceOrn.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("resource_orn"), "Invalid resource ORN", err.Error())
+			return
+		}
+		grants := newEntitlementGrants()
+		grants.addEntitlements(data.Entitlements)
+		body.GrantTypeCustomWriteable = &governance.GrantTypeCustomWriteable{
+			GrantType: string(governance.GRANTTYPE_CUSTOM),
+			Target: governance.TargetResource{
+				ExternalId: appID,
+				Type:       governance.RESOURCETYPE2_APPLICATION,
+			},
+			Entitlements:     grants.creatable(),
+			TargetPrincipal:  principal,
+			ScheduleSettings: scheduleSettings,
+		}
+	}
+
+	// Create API call logic
+	var grant governance.GrantFull
+	_, err = doGovernanceRequest(ctx, r.Config, http.MethodPost, grantsPath, body, &grant)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Grant",
+			"Could not create Grant, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	applyGrantToState(&grant, &data)
+
+	// Save Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *grantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data grantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	var grant governance.GrantFull
+	apiResp, err := doGovernanceRequest(ctx, r.Config, http.MethodGet, grantsPath+"/"+data.Id.ValueString(), nil, &grant)
+	if err != nil {
+		if utils.Is404(apiResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading Grant",
+			"Could not read Grant with ID "+data.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	// revoked and expired grants are gone for good
+	if !isGrantActive(grant.GetStatus()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	applyGrantToState(&grant, &data)
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *grantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state grantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic
+	var grant governance.GrantFull
+	_, err := doGovernanceRequest(ctx, r.Config, http.MethodGet, grantsPath+"/"+state.Id.ValueString(), nil, &grant)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Grant",
+			"Could not read Grant with ID "+state.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	planned, current := newEntitlementGrants(), newEntitlementGrants()
+	planned.addEntitlements(data.Entitlements)
+	current.addEntitlements(state.Entitlements)
+	if !reflect.DeepEqual(planned, current) {
+		// only the entitlements of CUSTOM grants can be replaced
+		grant.Entitlements = planned.creatable()
+		_, err = doGovernanceRequest(ctx, r.Config, http.MethodPut, grantsPath+"/"+state.Id.ValueString(), grant, &grant)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Grant",
+				"Could not replace the entitlements of Grant with ID "+state.Id.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !data.ScheduledRevocation.Equal(state.ScheduledRevocation) {
+		scheduleSettings, err := grantScheduleSettings(data.ScheduledRevocation)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("scheduled_revocation"), "Invalid scheduled revocation", err.Error())
+			return
+		}
+		if scheduleSettings == nil {
+			scheduleSettings = &governance.ScheduleSettingsWriteable{}
+		}
+		_, err = r.patchGrant(ctx, state.Id.ValueString(), *scheduleSettings, &grant)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Grant",
+				"Could not update the scheduled revocation of Grant with ID "+state.Id.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	applyGrantToState(&grant, &data)
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *grantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data grantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic, grants cannot be deleted: they are revoked by
+	// expiring them now
+	now := r.TimeOperations.Now().UTC()
+	apiResp, err := r.patchGrant(ctx, data.Id.ValueString(), governance.ScheduleSettingsWriteable{ExpirationDate: &now}, nil)
+	if err != nil {
+		if utils.Is404(apiResp) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting Grant",
+			"Could not revoke Grant with ID "+data.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// patchGrant sets the schedule settings of the grant, the only property of a
+// grant the API can update.
+func (r *grantResource) patchGrant(ctx context.Context, id string, scheduleSettings governance.ScheduleSettingsWriteable, v *governance.GrantFull) (*sdk.Response, error) {
+	body := governance.GrantPatch{Id: id, ScheduleSettings: scheduleSettings}
+	var grant governance.GrantFull
+	apiResp, err := doGovernanceRequest(ctx, r.Config, http.MethodPatch, grantsPath+"/"+id, body, &grant)
+	if err != nil {
+		return apiResp, err
+	}
+	if v != nil {
+		*v = grant
+	}
+	return apiResp, nil
+}
+
+// grantScheduleSettings returns the schedule settings revoking the grant at
+// the scheduled revocation, nil when the grant is not scheduled for revocation.
+func grantScheduleSettings(scheduledRevocation types.String) (*governance.ScheduleSettingsWriteable, error) {
+	expirationDate, err := parseRFC3339(scheduledRevocation)
+	if err != nil || expirationDate == nil {
+		return nil, err
+	}
+	return &governance.ScheduleSettingsWriteable{ExpirationDate: expirationDate}, nil
+}
+
+func isGrantActive(status governance.GrantStatus) bool {
+	return status == governance.GRANTSTATUS_ACTIVE || status == governance.GRANTSTATUS_SCHEDULED
+}
+
+// appIDFromResourceOrn returns the ID of the app of an app ORN, e.g.
+// 0oafxqCAJWWGELFTYASJ for orn:okta:idp:00o1n8sbwArJ7OQRw406:apps:salesforce:0oafxqCAJWWGELFTYASJ.
+func appIDFromResourceOrn(resourceOrn string) (string, error) {
+	parts := strings.Split(resourceOrn, ":")
+	if len(parts) != 7 || parts[0] != "orn" || parts[4] != "apps" || parts[6] == "" {
+		return "", fmt.Errorf("%q is not the ORN of an app", resourceOrn)
+	}
+	return parts[6], nil
+}
+
+func applyGrantToState(grant *governance.GrantFull, data *grantResourceModel) {
+	data.Id = types.StringValue(grant.GetId())
+	data.PrincipalId = types.StringValue(grant.TargetPrincipal.GetExternalId())
+	data.PrincipalOrn = types.StringValue(grant.GetTargetPrincipalOrn())
+	data.ResourceOrn = types.StringValue(grant.GetTargetResourceOrn())
+	data.EntitlementBundleId = types.StringPointerValue(grant.EntitlementBundleId)
+	data.GrantType = types.StringValue(string(grant.GetGrantType()))
+	data.Status = types.StringValue(string(grant.GetStatus()))
+
+	// the entitlements of bundle grants are managed by the bundle
+	data.Entitlements = nil
+	if grant.GetGrantType() == governance.GRANTTYPE_CUSTOM {
+		grants := newEntitlementGrants()
+		grants.addCreatable(grant.GetEntitlements())
+		data.Entitlements = grants.blocks()
+	}
+
+	var expirationDate *time.Time
+	if grant.ScheduleSettings != nil {
+		expirationDate = grant.ScheduleSettings.ExpirationDate
+	}
+	data.ScheduledRevocation = rfc3339Value(data.ScheduledRevocation, expirationDate)
+}
@@ -0,0 +1,81 @@
+package governance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccGrantResource_basic(t *testing.T) {
+	acctest.RequireSKU(t, config.SKUGovernance)
+	mgr := newFixtureManager("resources", resources.OktaGovernanceGrant, t.Name())
+	tfConfig := mgr.GetFixtures("basic.tf", t)
+	updatedConfig := mgr.GetFixtures("updated.tf", t)
+	resourceName := fmt.Sprintf("%s.test", resources.OktaGovernanceGrant)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "grant_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "principal_orn", "orn:oktapreview:directory:00onkw1sbuAh3Q06I1d7:users:00unkw1sfbTw08c0g1d7"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_revocation", "2026-12-31T23:59:59Z"),
+					resource.TestCheckResourceAttr(resourceName, "entitlements.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entitlements.0.values.#", "1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "scheduled_revocation", "2027-06-30T23:59:59Z"),
+					resource.TestCheckResourceAttr(resourceName, "entitlements.0.values.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGrantResource_bundle(t *testing.T) {
+	acctest.RequireSKU(t, config.SKUGovernance)
+	mgr := newFixtureManager("resources", resources.OktaGovernanceGrant, t.Name())
+	tfConfig := mgr.GetFixtures("bundle.tf", t)
+	resourceName := fmt.Sprintf("%s.test", resources.OktaGovernanceGrant)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "entitlement_bundle_id", "okta_entitlement_bundle.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "grant_type", "ENTITLEMENT-BUNDLE"),
+					resource.TestCheckResourceAttr(resourceName, "resource_orn", "orn:oktapreview:idp:00onkw1sbuAh3Q06I1d7:apps:oidc_client:0oao01ardu8r8qUP91d7"),
+					resource.TestCheckNoResourceAttr(resourceName, "scheduled_revocation"),
+					resource.TestCheckResourceAttr(resourceName, "entitlements.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
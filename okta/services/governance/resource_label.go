@@ -0,0 +1,336 @@
+package governance
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+)
+
+const (
+	labelsPath                = "/governance/api/v1/labels"
+	labelBackgroundColorKey   = "backgroundColor"
+	labelPatchRefTypeCategory = "LABEL-CATEGORY"
+	labelPatchRefTypeValue    = "LABEL-VALUE"
+	// labelPatchMaxOperations is the maximum number of operations of a label
+	// update request.
+	labelPatchMaxOperations = 10
+)
+
+var (
+	_ resource.Resource                = &labelResource{}
+	_ resource.ResourceWithConfigure   = &labelResource{}
+	_ resource.ResourceWithImportState = &labelResource{}
+)
+
+func newLabelResource() resource.Resource {
+	return &labelResource{}
+}
+
+type labelResource struct {
+	*config.Config
+}
+
+type labelResourceModel struct {
+	Id     types.String      `tfsdk:"id"`
+	Name   types.String      `tfsdk:"name"`
+	Values []labelValueModel `tfsdk:"values"`
+}
+
+type labelValueModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	BackgroundColor types.String `tfsdk:"background_color"`
+}
+
+func (r *labelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_label"
+}
+
+func (r *labelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *labelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *labelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a governance label, a category such as `Compliance` with values such as `SOX` and `PCI`. Use `okta_label_assignment` to label resources with the values.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the label",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the label",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"values": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Unique identifier of the label value, used to assign the value to resources.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the label value",
+							Required:    true,
+						},
+						"background_color": schema.StringAttribute{
+							Description: "Background color of the label value in the Admin Console, e.g. `blue`.",
+							Optional:    true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				Description: "The values of the label. Values are matched by name on update, a renamed value keeps its ID when it stays at the same position among the renamed values.",
+			},
+		},
+	}
+}
+
+func (r *labelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data labelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	body := governance.LabelCreate{Name: data.Name.ValueString()}
+	for _, value := range data.Values {
+		body.Values = append(body.Values, governance.LabelValueCreate{
+			Name:     value.Name.ValueString(),
+			Metadata: buildLabelMetadata(value),
+		})
+	}
+	var label governance.Label
+	_, err := doGovernanceRequest(ctx, r.Config, http.MethodPost, labelsPath, body, &label)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Label",
+			"Could not create Label, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	applyLabelToState(&label, &data)
+
+	// Save Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *labelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data labelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	var label governance.Label
+	apiResp, err := doGovernanceRequest(ctx, r.Config, http.MethodGet, labelsPath+"/"+data.Id.ValueString(), nil, &label)
+	if err != nil {
+		if utils.Is404(apiResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading Label",
+			"Could not read Label with ID "+data.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	applyLabelToState(&label, &data)
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *labelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state labelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic
+	labelPath := labelsPath + "/" + state.Id.ValueString()
+	for ops := range slices.Chunk(buildLabelPatch(state, data), labelPatchMaxOperations) {
+		_, err := doGovernanceRequest(ctx, r.Config, http.MethodPatch, labelPath, ops, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Label",
+				"Could not update Label with ID "+state.Id.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	var label governance.Label
+	_, err := doGovernanceRequest(ctx, r.Config, http.MethodGet, labelPath, nil, &label)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Label",
+			"Could not read Label with ID "+state.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	applyLabelToState(&label, &data)
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *labelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data labelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	apiResp, err := doGovernanceRequest(ctx, r.Config, http.MethodDelete, labelsPath+"/"+data.Id.ValueString(), nil, nil)
+	if err != nil {
+		if utils.Is404(apiResp) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting Label",
+			"Could not delete Label with ID "+data.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func buildLabelMetadata(value labelValueModel) *governance.LabelMetadata {
+	if value.BackgroundColor.ValueString() == "" {
+		return nil
+	}
+	return &governance.LabelMetadata{
+		AdditionalPropertiesField: map[string]interface{}{
+			labelBackgroundColorKey: value.BackgroundColor.ValueString(),
+		},
+	}
+}
+
+// buildLabelPatch returns the operations updating the label from its state to
+// the plan. Planned values are matched with the values of the state by name,
+// the remaining ones are renamed in order, then added or removed.
+func buildLabelPatch(state, plan labelResourceModel) []governance.PatchLabelsInner {
+	var ops []governance.PatchLabelsInner
+	if !plan.Name.Equal(state.Name) {
+		ops = append(ops, governance.PatchLabelsInner{PatchLabelOperation: &governance.PatchLabelOperation{
+			Op:      governance.LABELPATCHOP_REPLACE,
+			Path:    "/name",
+			Value:   plan.Name.ValueStringPointer(),
+			RefType: labelPatchRefTypeCategory,
+		}})
+	}
+
+	var added []labelValueModel
+	removed := slices.Clone(state.Values)
+	var matched []labelValueModel
+	for _, value := range plan.Values {
+		idx := slices.IndexFunc(removed, func(v labelValueModel) bool { return v.Name.Equal(value.Name) })
+		if idx < 0 {
+			added = append(added, value)
+			continue
+		}
+		if !removed[idx].BackgroundColor.Equal(value.BackgroundColor) {
+			value.Id = removed[idx].Id
+			matched = append(matched, value)
+		}
+		removed = slices.Delete(removed, idx, idx+1)
+	}
+	for len(added) > 0 && len(removed) > 0 {
+		value := added[0]
+		value.Id = removed[0].Id
+		matched = append(matched, value)
+		added, removed = added[1:], removed[1:]
+	}
+
+	for _, value := range matched {
+		ops = append(ops, governance.PatchLabelsInner{PatchLabelValueOperation: &governance.PatchLabelValueOperation{
+			Op:   governance.LABELVALUEPATCHOP_REPLACE,
+			Path: "/values/" + value.Id.ValueString(),
+			Value: &governance.LabelValueUpdate{
+				Name:     value.Name.ValueStringPointer(),
+				Metadata: buildLabelMetadata(value),
+			},
+			RefType: labelPatchRefTypeValue,
+		}})
+	}
+	for _, value := range added {
+		ops = append(ops, governance.PatchLabelsInner{PatchLabelValueOperation: &governance.PatchLabelValueOperation{
+			Op:   governance.LABELVALUEPATCHOP_ADD,
+			Path: "/values/-",
+			Value: &governance.LabelValueUpdate{
+				Name:     value.Name.ValueStringPointer(),
+				Metadata: buildLabelMetadata(value),
+			},
+			RefType: labelPatchRefTypeValue,
+		}})
+	}
+	for _, value := range removed {
+		ops = append(ops, governance.PatchLabelsInner{PatchLabelValueOperation: &governance.PatchLabelValueOperation{
+			Op:      governance.LABELVALUEPATCHOP_REMOVE,
+			Path:    "/values/" + value.Id.ValueString(),
+			RefType: labelPatchRefTypeValue,
+		}})
+	}
+	return ops
+}
+
+// applyLabelToState sets the label in the model, keeping the order of the
+// values of the model.
+func applyLabelToState(label *governance.Label, data *labelResourceModel) {
+	data.Id = types.StringValue(label.GetLabelId())
+	data.Name = types.StringValue(label.GetName())
+
+	remaining := slices.Clone(label.GetValues())
+	var values []labelValueModel
+	for _, prior := range data.Values {
+		idx := slices.IndexFunc(remaining, func(v governance.LabelValue) bool { return v.GetName() == prior.Name.ValueString() })
+		if idx < 0 {
+			continue
+		}
+		values = append(values, labelValueToModel(remaining[idx]))
+		remaining = slices.Delete(remaining, idx, idx+1)
+	}
+	for _, value := range remaining {
+		values = append(values, labelValueToModel(value))
+	}
+	data.Values = values
+}
+
+func labelValueToModel(value governance.LabelValue) labelValueModel {
+	model := labelValueModel{
+		Id:              types.StringValue(value.GetLabelValueId()),
+		Name:            types.StringValue(value.GetName()),
+		BackgroundColor: types.StringNull(),
+	}
+	if color, ok := value.Metadata.GetAdditionalPropertiesField()[labelBackgroundColorKey].(string); ok {
+		model.BackgroundColor = types.StringValue(color)
+	}
+	return model
+}
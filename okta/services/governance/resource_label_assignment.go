@@ -0,0 +1,221 @@
+package governance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+const resourceLabelsPath = "/governance/api/v1/resource-labels"
+
+var (
+	_ resource.Resource                = &labelAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &labelAssignmentResource{}
+	_ resource.ResourceWithImportState = &labelAssignmentResource{}
+)
+
+func newLabelAssignmentResource() resource.Resource {
+	return &labelAssignmentResource{}
+}
+
+type labelAssignmentResource struct {
+	*config.Config
+}
+
+type labelAssignmentResourceModel struct {
+	Id           types.String   `tfsdk:"id"`
+	LabelValueId types.String   `tfsdk:"label_value_id"`
+	ResourceOrns []types.String `tfsdk:"resource_orns"`
+}
+
+func (r *labelAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_label_assignment"
+}
+
+func (r *labelAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *labelAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("label_value_id"), req.ID)...)
+}
+
+func (r *labelAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assigns a label value to resources. This resource is authoritative for the label value: resources labeled with the value outside of Terraform are unlabeled on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the label value.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label_value_id": schema.StringAttribute{
+				Description: "The ID of the label value to assign, see the `values` of `okta_label`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_orns": schema.SetAttribute{
+				Description: "The ORNs of the resources labeled with the value: apps, groups, entitlement bundles, collections or entitlement values.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *labelAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data labelAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	err := r.setResourceLabels(ctx, "assign", data.LabelValueId.ValueString(), data.ResourceOrns)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Label Assignment",
+			"Could not assign label value "+data.LabelValueId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	data.Id = data.LabelValueId
+
+	// Save Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *labelAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data labelAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	labeled, err := listLabeledResources(ctx, r.Config, fmt.Sprintf("labelValueId eq %q", data.LabelValueId.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Label Assignment",
+			"Could not list the resources labeled with "+data.LabelValueId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if len(labeled) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.ResourceOrns = make([]types.String, len(labeled))
+	for i, res := range labeled {
+		data.ResourceOrns[i] = types.StringPointerValue(res.Orn)
+	}
+	data.Id = data.LabelValueId
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *labelAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state labelAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic
+	added := slices.DeleteFunc(slices.Clone(data.ResourceOrns), func(orn types.String) bool { return slices.Contains(state.ResourceOrns, orn) })
+	removed := slices.DeleteFunc(slices.Clone(state.ResourceOrns), func(orn types.String) bool { return slices.Contains(data.ResourceOrns, orn) })
+	if len(added) > 0 {
+		err := r.setResourceLabels(ctx, "assign", data.LabelValueId.ValueString(), added)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Label Assignment",
+				"Could not assign label value "+data.LabelValueId.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	if len(removed) > 0 {
+		err := r.setResourceLabels(ctx, "unassign", data.LabelValueId.ValueString(), removed)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Label Assignment",
+				"Could not unassign label value "+data.LabelValueId.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	data.Id = data.LabelValueId
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *labelAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data labelAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	err := r.setResourceLabels(ctx, "unassign", data.LabelValueId.ValueString(), data.ResourceOrns)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Label Assignment",
+			"Could not unassign label value "+data.LabelValueId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// setResourceLabels assigns or unassigns, depending on the action, the label
+// value to the resources.
+func (r *labelAssignmentResource) setResourceLabels(ctx context.Context, action, labelValueID string, orns []types.String) error {
+	body := governance.AssignResourceLabels{LabelValueIds: []string{labelValueID}}
+	for _, orn := range orns {
+		body.ResourceOrns = append(body.ResourceOrns, orn.ValueString())
+	}
+	_, err := doGovernanceRequest(ctx, r.Config, http.MethodPost, resourceLabelsPath+"/"+action, body, nil)
+	return err
+}
+
+// listLabeledResources returns all the labeled resources matching the filter,
+// following the pagination cursor.
+func listLabeledResources(ctx context.Context, c *config.Config, filter string) ([]governance.ResourceLabel, error) {
+	var all []governance.ResourceLabel
+	after := ""
+	for {
+		var list governance.ListResourceLabels
+		_, err := doGovernanceRequest(ctx, c, http.MethodGet, governanceListPath(resourceLabelsPath, filter, after), nil, &list)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, list.GetData()...)
+		after = nextPageCursor(&list.Links)
+		if after == "" {
+			return all, nil
+		}
+	}
+}
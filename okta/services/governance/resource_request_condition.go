@@ -2,6 +2,7 @@ package governance
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
@@ -198,6 +199,10 @@ func (r *requestConditionResource) Create(ctx context.Context, req resource.Crea
 	// Read Terraform plan Data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.validateApprovalSequence(ctx, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -328,6 +333,13 @@ func (r *requestConditionResource) Update(ctx context.Context, req resource.Upda
 	// 0 for priority in the response, so we preserve the planned value.
 	plannedPriority := data.Priority
 
+	if !data.ApprovalSequenceId.Equal(state.ApprovalSequenceId) {
+		resp.Diagnostics.Append(r.validateApprovalSequence(ctx, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Update API call logic
 	ctx = context.WithValue(ctx, api.RetryOnStatusCodes, []int{http.StatusConflict})
 	updatedRequestCondition, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().RequestConditionsAPI.UpdateResourceRequestConditionV2(ctx, data.ResourceId.ValueString(), state.Id.ValueString()).RequestConditionPatchable(createRequestConditionPatch(data)).Execute()
@@ -436,6 +448,29 @@ func (r *requestConditionResource) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
+// validateApprovalSequence checks that the approval sequence of the condition
+// belongs to the resource of the condition.
+func (r *requestConditionResource) validateApprovalSequence(ctx context.Context, data requestConditionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	resourceID, sequenceID := data.ResourceId.ValueString(), data.ApprovalSequenceId.ValueString()
+	_, httpResp, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().RequestSequencesAPI.GetResourceRequestSequenceV2(ctx, resourceID, sequenceID).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			diags.AddAttributeError(
+				path.Root("approval_sequence_id"),
+				"Invalid approval sequence",
+				fmt.Sprintf("Request sequence %q does not belong to resource %q, use a sequence of the resource, e.g. from the okta_request_sequence data source.", sequenceID, resourceID),
+			)
+			return diags
+		}
+		diags.AddError(
+			"Error reading Request Sequence",
+			"Could not read Request Sequence "+sequenceID+", unexpected error: "+err.Error(),
+		)
+	}
+	return diags
+}
+
 func applyRequestConditionToState(ctx context.Context, data *requestConditionResourceModel, requestConditionResp *governance.RequestConditionFull) diag.Diagnostics {
 	var diags diag.Diagnostics
 	data.Id = types.StringValue(requestConditionResp.GetId())
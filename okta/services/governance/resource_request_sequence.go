@@ -2,6 +2,7 @@ package governance
 
 import (
 	"context"
+	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -63,6 +64,7 @@ func (r *requestSequenceResource) Metadata(ctx context.Context, req resource.Met
 
 func (r *requestSequenceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Manages an access request sequence. Request sequences cannot be created through the API: creating this resource adopts an existing sequence of the resource, changing `id` or `resource_id` adopts another sequence and keeps the previous one, and destroying it deletes the sequence.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Required:    true,
@@ -97,11 +99,30 @@ func (r *requestSequenceResource) Schema(ctx context.Context, req resource.Schem
 	}
 }
 
+// Create adopts an existing request sequence: the API has no operation to
+// create sequences, they are built in the Admin Console.
 func (r *requestSequenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	resp.Diagnostics.AddWarning(
-		"Create Not Supported",
-		"This resource cannot be created via Terraform. Please import it or let Terraform read it from the existing system.",
-	)
+	var data requestSequenceResourceModel
+
+	// Read Terraform plan Data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	readResourceRequestSeqResp, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().RequestSequencesAPI.GetResourceRequestSequenceV2(ctx, data.ResourceId.ValueString(), data.Id.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Request Sequence",
+			"Could not read Request Sequence "+data.Id.ValueString()+" of resource "+data.ResourceId.ValueString()+", request sequences cannot be created through the API and must exist in Okta, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	applyRequestSequenceToState(readResourceRequestSeqResp, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *requestSequenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -115,25 +136,31 @@ func (r *requestSequenceResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Read API call logic
-	readResourceRequestSeqResp, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().RequestSequencesAPI.GetResourceRequestSequenceV2(ctx, data.ResourceId.ValueString(), data.Id.ValueString()).Execute()
+	readResourceRequestSeqResp, httpResp, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().RequestSequencesAPI.GetResourceRequestSequenceV2(ctx, data.ResourceId.ValueString(), data.Id.ValueString()).Execute()
 	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading Request Sequence",
 			"Could not read Request Sequence, unexpected error: "+err.Error(),
 		)
 		return
 	}
-
-	data.Id = types.StringValue(readResourceRequestSeqResp.Id)
-	data.Name = types.StringValue(readResourceRequestSeqResp.Name)
-	data.ResourceId = types.StringValue(data.ResourceId.ValueString())
-	data.Description = types.StringValue(readResourceRequestSeqResp.Description)
-	data.Link = types.StringValue(readResourceRequestSeqResp.Link)
-	data.CompatibleResourceTypes = setCompatibleResourceType(readResourceRequestSeqResp.CompatibleResourceTypes)
+	applyRequestSequenceToState(readResourceRequestSeqResp, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func applyRequestSequenceToState(sequence *governance.RequestSequence, data *requestSequenceResourceModel) {
+	data.Id = types.StringValue(sequence.Id)
+	data.Name = types.StringValue(sequence.Name)
+	data.Description = types.StringValue(sequence.Description)
+	data.Link = types.StringValue(sequence.Link)
+	data.CompatibleResourceTypes = setCompatibleResourceType(sequence.CompatibleResourceTypes)
+}
+
 func setCompatibleResourceType(resourceTypes []governance.CompatibleResourceTypes) types.List {
 	values := make([]attr.Value, len(resourceTypes))
 	for i, resourceType := range resourceTypes {
@@ -143,11 +170,31 @@ func setCompatibleResourceType(resourceTypes []governance.CompatibleResourceType
 	return listVal
 }
 
+// Update adopts the planned sequence in place: replacing the resource would
+// delete the previously adopted sequence, which cannot be recreated through
+// the API.
 func (r *requestSequenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddWarning(
-		"Update Not Supported",
-		"This resource cannot be updated via Terraform. Please import it or let Terraform read it from the existing system.",
-	)
+	var data requestSequenceResourceModel
+
+	// Read Terraform plan Data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	readResourceRequestSeqResp, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().RequestSequencesAPI.GetResourceRequestSequenceV2(ctx, data.ResourceId.ValueString(), data.Id.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Request Sequence",
+			"Could not read Request Sequence "+data.Id.ValueString()+" of resource "+data.ResourceId.ValueString()+", request sequences cannot be created through the API and must exist in Okta, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	applyRequestSequenceToState(readResourceRequestSeqResp, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *requestSequenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -0,0 +1,261 @@
+package governance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+const resourceOwnersPath = "/governance/api/v1/resource-owners"
+
+var (
+	_ resource.Resource                = &resourceOwnerResource{}
+	_ resource.ResourceWithConfigure   = &resourceOwnerResource{}
+	_ resource.ResourceWithImportState = &resourceOwnerResource{}
+)
+
+func newResourceOwnerResource() resource.Resource {
+	return &resourceOwnerResource{}
+}
+
+type resourceOwnerResource struct {
+	*config.Config
+}
+
+type resourceOwnerResourceModel struct {
+	Id                types.String   `tfsdk:"id"`
+	ResourceOrn       types.String   `tfsdk:"resource_orn"`
+	ParentResourceOrn types.String   `tfsdk:"parent_resource_orn"`
+	PrincipalOrns     []types.String `tfsdk:"principal_orns"`
+}
+
+func (r *resourceOwnerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_owner"
+}
+
+func (r *resourceOwnerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *resourceOwnerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_orn"), req.ID)...)
+}
+
+func (r *resourceOwnerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the owners of a resource. Resource owners can be assigned as reviewers of access certification campaigns and approvers of access requests.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ORN of the resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_orn": schema.StringAttribute{
+				Description: "The ORN of the owned resource, an app or an entitlement bundle.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_resource_orn": schema.StringAttribute{
+				Description: "The ORN of the app of the resource. Defaults to the app of the entitlement bundle when `resource_orn` is an entitlement bundle, to `resource_orn` otherwise.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_orns": schema.SetAttribute{
+				Description: "The ORNs of the users or groups owning the resource.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceOwnerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceOwnerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	owners, err := r.putResourceOwners(ctx, data.ResourceOrn.ValueString(), data.PrincipalOrns)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Resource Owner",
+			"Could not set the owners of "+data.ResourceOrn.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	owner := findResourceOwner(owners, data.ResourceOrn.ValueString())
+	if owner == nil {
+		resp.Diagnostics.AddError(
+			"Error creating Resource Owner",
+			"Could not find the owners of "+data.ResourceOrn.ValueString()+" in the response.",
+		)
+		return
+	}
+	applyResourceOwnerToState(owner, &data)
+
+	// Save Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceOwnerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceOwnerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	resourceOrn := data.ResourceOrn.ValueString()
+	parentOrn := data.ParentResourceOrn.ValueString()
+	if parentOrn == "" {
+		var err error
+		parentOrn, err = r.defaultParentResourceOrn(ctx, resourceOrn)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading Resource Owner",
+				"Could not find the app of "+resourceOrn+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	var list governance.ResourceOwnersListResponse
+	filter := fmt.Sprintf("parentResourceOrn eq %q AND resource.orn eq %q", parentOrn, resourceOrn)
+	_, err := doGovernanceRequest(ctx, r.Config, http.MethodGet, governanceListPath(resourceOwnersPath, filter, ""), nil, &list)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Resource Owner",
+			"Could not read the owners of "+resourceOrn+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	owner := findResourceOwner(list.GetData(), resourceOrn)
+	if owner == nil || len(owner.GetPrincipals()) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	applyResourceOwnerToState(owner, &data)
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceOwnerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resourceOwnerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic
+	owners, err := r.putResourceOwners(ctx, data.ResourceOrn.ValueString(), data.PrincipalOrns)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Resource Owner",
+			"Could not set the owners of "+data.ResourceOrn.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	owner := findResourceOwner(owners, data.ResourceOrn.ValueString())
+	if owner == nil {
+		resp.Diagnostics.AddError(
+			"Error updating Resource Owner",
+			"Could not find the owners of "+data.ResourceOrn.ValueString()+" in the response.",
+		)
+		return
+	}
+	applyResourceOwnerToState(owner, &data)
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceOwnerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceOwnerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic, a resource without principals has no owner
+	_, err := r.putResourceOwners(ctx, data.ResourceOrn.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Resource Owner",
+			"Could not remove the owners of "+data.ResourceOrn.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// putResourceOwners replaces the owners of the resource.
+func (r *resourceOwnerResource) putResourceOwners(ctx context.Context, resourceOrn string, principalOrns []types.String) ([]governance.ResourceOwner, error) {
+	body := governance.ResourceOwnersUpdatable{ResourceOrns: []string{resourceOrn}}
+	for _, orn := range principalOrns {
+		body.PrincipalOrns = append(body.PrincipalOrns, orn.ValueString())
+	}
+	var owners governance.ResourceOwnersResponse
+	_, err := doGovernanceRequest(ctx, r.Config, http.MethodPut, resourceOwnersPath, body, &owners)
+	if err != nil {
+		return nil, err
+	}
+	return owners.GetData(), nil
+}
+
+// defaultParentResourceOrn returns the ORN of the app of an entitlement bundle,
+// the resource ORN itself for the other resources.
+func (r *resourceOwnerResource) defaultParentResourceOrn(ctx context.Context, resourceOrn string) (string, error) {
+	parts := strings.Split(resourceOrn, ":")
+	if len(parts) != 6 || parts[4] != "entitlement-bundles" {
+		return resourceOrn, nil
+	}
+	bundle, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().EntitlementBundlesAPI.GetentitlementBundle(ctx, parts[5]).Execute()
+	if err != nil {
+		return "", err
+	}
+	return bundle.GetTargetResourceOrn(), nil
+}
+
+func findResourceOwner(owners []governance.ResourceOwner, resourceOrn string) *governance.ResourceOwner {
+	for i := range owners {
+		if owners[i].Resource.GetOrn() == resourceOrn {
+			return &owners[i]
+		}
+	}
+	return nil
+}
+
+func applyResourceOwnerToState(owner *governance.ResourceOwner, data *resourceOwnerResourceModel) {
+	data.Id = types.StringValue(owner.Resource.GetOrn())
+	data.ResourceOrn = types.StringValue(owner.Resource.GetOrn())
+	data.ParentResourceOrn = types.StringValue(owner.GetParentResourceOrn())
+	data.PrincipalOrns = make([]types.String, len(owner.GetPrincipals()))
+	for i, principal := range owner.GetPrincipals() {
+		data.PrincipalOrns[i] = types.StringValue(principal.GetOrn())
+	}
+}
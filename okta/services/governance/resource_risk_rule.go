@@ -0,0 +1,548 @@
+package governance
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-governance-sdk-golang/governance"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+const (
+	riskRuleTypeSeparationOfDuties = "SEPARATION_OF_DUTIES"
+	riskRuleCriteriaAttribute      = "principal.effective_grants"
+	riskRuleCriteriaValueType      = "ENTITLEMENTS"
+)
+
+var (
+	_ resource.Resource                = &riskRuleResource{}
+	_ resource.ResourceWithConfigure   = &riskRuleResource{}
+	_ resource.ResourceWithImportState = &riskRuleResource{}
+)
+
+func newRiskRuleResource() resource.Resource {
+	return &riskRuleResource{}
+}
+
+type riskRuleResource struct {
+	*config.Config
+}
+
+type riskRuleResourceModel struct {
+	Id               types.String            `tfsdk:"id"`
+	Name             types.String            `tfsdk:"name"`
+	Description      types.String            `tfsdk:"description"`
+	Notes            types.String            `tfsdk:"notes"`
+	ResourceOrn      types.String            `tfsdk:"resource_orn"`
+	Type             types.String            `tfsdk:"type"`
+	Status           types.String            `tfsdk:"status"`
+	Created          types.String            `tfsdk:"created"`
+	CreatedBy        types.String            `tfsdk:"created_by"`
+	LastUpdated      types.String            `tfsdk:"last_updated"`
+	LastUpdatedBy    types.String            `tfsdk:"last_updated_by"`
+	ConflictCriteria []riskRuleCriteriaModel `tfsdk:"conflict_criteria"`
+}
+
+type riskRuleCriteriaModel struct {
+	Name               types.String             `tfsdk:"name"`
+	Operation          types.String             `tfsdk:"operation"`
+	Entitlements       []entitlements           `tfsdk:"entitlements"`
+	EntitlementBundles []entitlementBundleModel `tfsdk:"entitlement_bundles"`
+}
+
+func (r *riskRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_risk_rule"
+}
+
+func (r *riskRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *riskRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *riskRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a separation of duties risk rule. A user is in conflict with the rule when they hold grants matching both conflict criteria of the rule on the resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the risk rule",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the risk rule",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the risk rule",
+				Optional:    true,
+			},
+			"notes": schema.StringAttribute{
+				Description: "Additional information about the risk rule",
+				Optional:    true,
+			},
+			"resource_orn": schema.StringAttribute{
+				Description: "The ORN of the application the risk rule applies to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the risk rule, always `SEPARATION_OF_DUTIES`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the risk rule",
+				Computed:    true,
+			},
+			"created": schema.StringAttribute{
+				Description: "The ISO 8601 formatted date and time when the risk rule was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Description: "The id of the Okta user who created the risk rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "The ISO 8601 formatted date and time when the risk rule was last updated.",
+				Computed:    true,
+			},
+			"last_updated_by": schema.StringAttribute{
+				Description: "The id of the Okta user who last updated the risk rule.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"conflict_criteria": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the criteria",
+							Required:    true,
+						},
+						"operation": schema.StringAttribute{
+							Description: "`CONTAINS_ONE` when holding one of the entitlement values matches the criteria, `CONTAINS_ALL` when all of them must be held.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("CONTAINS_ONE", "CONTAINS_ALL"),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"entitlements": schema.SetNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "Entitlement ID",
+										Required:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"values": schema.SetNestedBlock{
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"id": schema.StringAttribute{
+													Description: "Entitlement value ID",
+													Required:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+							Description: "Entitlements and their values matched by the criteria.",
+						},
+						"entitlement_bundles": schema.SetNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "The ID of the entitlement bundle.",
+										Required:    true,
+									},
+								},
+							},
+							Description: "Entitlement bundles whose entitlements are matched by the criteria. Risk rules only hold entitlements, the entitlements of the bundles are added to the rule when it is created or updated.",
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeBetween(2, 2),
+				},
+				Description: "The two criteria of the rule. A conflict occurs when a user matches both.",
+			},
+		},
+	}
+}
+
+func (r *riskRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data riskRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria, diags := r.buildRiskRuleCriteria(ctx, data.ConflictCriteria)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	resourceOrn := data.ResourceOrn.ValueString()
+	riskRule, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().RiskRulesAPI.CreateRiskRule(ctx).CreateRiskRuleRequest(governance.CreateRiskRuleRequest{
+		Name:             data.Name.ValueString(),
+		Description:      data.Description.ValueStringPointer(),
+		Notes:            data.Notes.ValueStringPointer(),
+		Type:             riskRuleTypeSeparationOfDuties,
+		Resources:        []governance.RuleConflictResource{{ResourceOrn: &resourceOrn}},
+		ConflictCriteria: governance.ConflictCriteriaCreatable{And: criteria},
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Risk Rule",
+			"Could not create Risk Rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(r.applyRiskRuleToState(ctx, riskRule, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *riskRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data riskRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	riskRule, httpResp, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().RiskRulesAPI.GetRiskRule(ctx, data.Id.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading Risk Rule",
+			"Could not read Risk Rule with ID "+data.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(r.applyRiskRuleToState(ctx, riskRule, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *riskRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data riskRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria, diags := r.buildRiskRuleCriteria(ctx, data.ConflictCriteria)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic
+	body := governance.UpdateRiskRuleRequest{Id: data.Id.ValueString()}
+	body.SetName(data.Name.ValueString())
+	if data.Description.IsNull() {
+		body.SetDescriptionNil()
+	} else {
+		body.SetDescription(data.Description.ValueString())
+	}
+	if data.Notes.IsNull() {
+		body.SetNotesNil()
+	} else {
+		body.SetNotes(data.Notes.ValueString())
+	}
+	body.SetConflictCriteria(governance.ConflictCriteriaUpdatable{And: criteria})
+	riskRule, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().RiskRulesAPI.ReplaceRiskRule(ctx, data.Id.ValueString()).UpdateRiskRuleRequest(body).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Risk Rule",
+			"Could not update Risk Rule with ID "+data.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(r.applyRiskRuleToState(ctx, riskRule, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated Data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *riskRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data riskRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	httpResp, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().RiskRulesAPI.DeleteRiskRule(ctx, data.Id.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting Risk Rule",
+			"Could not delete Risk Rule with ID "+data.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// buildRiskRuleCriteria returns the criteria of the rule, with the
+// entitlements of the entitlement bundles merged into the entitlements of each
+// criteria.
+func (r *riskRuleResource) buildRiskRuleCriteria(ctx context.Context, criteria []riskRuleCriteriaModel) ([]governance.CriteriaCreatable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := make([]governance.CriteriaCreatable, 0, len(criteria))
+	for _, c := range criteria {
+		grants := newEntitlementGrants()
+		grants.addEntitlements(c.Entitlements)
+		bundleGrants, err := r.entitlementBundleGrants(ctx, c.EntitlementBundles)
+		if err != nil {
+			diags.AddError(
+				"Error reading Entitlement Bundle",
+				"Could not read the entitlements of the bundles of criteria "+c.Name.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return nil, diags
+		}
+		grants.merge(bundleGrants)
+
+		valueType := riskRuleCriteriaValueType
+		result = append(result, governance.CriteriaCreatable{
+			Name:      c.Name.ValueStringPointer(),
+			Attribute: governance.PtrString(riskRuleCriteriaAttribute),
+			Operation: c.Operation.ValueStringPointer(),
+			Value: &governance.CriteriaValueCreatable{
+				Type:  &valueType,
+				Value: grants.creatable(),
+			},
+		})
+	}
+	return result, diags
+}
+
+func (r *riskRuleResource) entitlementBundleGrants(ctx context.Context, bundles []entitlementBundleModel) (entitlementGrants, error) {
+	grants := newEntitlementGrants()
+	for _, bundle := range bundles {
+		entitlementBundle, _, err := r.OktaGovernanceClient.OktaGovernanceSDKClient().EntitlementBundlesAPI.GetentitlementBundle(ctx, bundle.Id.ValueString()).Execute()
+		if err != nil {
+			return nil, err
+		}
+		grants.addFull(entitlementBundle.GetEntitlements())
+	}
+	return grants, nil
+}
+
+// applyRiskRuleToState sets the state from the rule. The entitlements of each
+// criteria only keep the values that do not come from the configured bundles,
+// unless they were configured explicitly as well.
+func (r *riskRuleResource) applyRiskRuleToState(ctx context.Context, riskRule *governance.RiskRuleResponse, data *riskRuleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	data.Id = types.StringValue(riskRule.GetId())
+	data.Name = types.StringValue(riskRule.GetName())
+	data.Description = types.StringPointerValue(riskRule.Description)
+	data.Notes = types.StringPointerValue(riskRule.Notes)
+	data.Type = types.StringValue(riskRule.GetType())
+	data.Status = types.StringValue(riskRule.GetStatus())
+	data.Created = types.StringValue(riskRule.GetCreated().Format(time.RFC3339))
+	data.CreatedBy = types.StringValue(riskRule.GetCreatedBy())
+	data.LastUpdated = types.StringValue(riskRule.GetLastUpdated().Format(time.RFC3339))
+	data.LastUpdatedBy = types.StringValue(riskRule.GetLastUpdatedBy())
+	if resources := riskRule.GetResources(); len(resources) > 0 {
+		data.ResourceOrn = types.StringValue(resources[0].GetResourceOrn())
+	}
+
+	prior := data.ConflictCriteria
+	criteria := riskRule.ConflictCriteria.GetAnd()
+	data.ConflictCriteria = make([]riskRuleCriteriaModel, 0, len(criteria))
+	for i, c := range criteria {
+		model := riskRuleCriteriaModel{
+			Name:      types.StringValue(c.GetName()),
+			Operation: types.StringValue(c.GetOperation()),
+		}
+		grants := newEntitlementGrants()
+		if c.Value != nil {
+			grants.addFull(c.Value.GetValue())
+		}
+		idx := slices.IndexFunc(prior, func(p riskRuleCriteriaModel) bool { return p.Name.ValueString() == c.GetName() })
+		if idx < 0 && i < len(prior) {
+			idx = i
+		}
+		if idx >= 0 {
+			model.EntitlementBundles = prior[idx].EntitlementBundles
+			if len(model.EntitlementBundles) > 0 {
+				bundleGrants, err := r.entitlementBundleGrants(ctx, model.EntitlementBundles)
+				if err != nil {
+					diags.AddError(
+						"Error reading Entitlement Bundle",
+						"Could not read the entitlements of the bundles of criteria "+c.GetName()+", unexpected error: "+err.Error(),
+					)
+					return diags
+				}
+				explicit := newEntitlementGrants()
+				explicit.addEntitlements(prior[idx].Entitlements)
+				grants = grants.without(bundleGrants, explicit)
+			}
+		}
+		model.Entitlements = grants.blocks()
+		data.ConflictCriteria = append(data.ConflictCriteria, model)
+	}
+	return diags
+}
+
+// entitlementGrants holds entitlement value IDs by entitlement ID.
+type entitlementGrants map[string]map[string]bool
+
+func newEntitlementGrants() entitlementGrants {
+	return entitlementGrants{}
+}
+
+func (g entitlementGrants) add(entitlementID string, valueIDs ...string) {
+	if g[entitlementID] == nil {
+		g[entitlementID] = map[string]bool{}
+	}
+	for _, valueID := range valueIDs {
+		g[entitlementID][valueID] = true
+	}
+}
+
+func (g entitlementGrants) addEntitlements(ents []entitlements) {
+	for _, ent := range ents {
+		g.add(ent.Id.ValueString())
+		for _, val := range ent.Values {
+			g.add(ent.Id.ValueString(), val.Id.ValueString())
+		}
+	}
+}
+
+func (g entitlementGrants) addFull(ents []governance.EntitlementFull) {
+	for _, ent := range ents {
+		g.add(ent.GetId())
+		for _, val := range ent.GetValues() {
+			g.add(ent.GetId(), val.GetId())
+		}
+	}
+}
+
+func (g entitlementGrants) addCreatable(ents []governance.EntitlementCreatable) {
+	for _, ent := range ents {
+		g.add(ent.GetId())
+		for _, val := range ent.GetValues() {
+			g.add(ent.GetId(), val.GetId())
+		}
+	}
+}
+
+func (g entitlementGrants) merge(other entitlementGrants) {
+	for entitlementID, values := range other {
+		g.add(entitlementID)
+		for valueID := range values {
+			g.add(entitlementID, valueID)
+		}
+	}
+}
+
+// without returns the grants that are not in remove, or that are in keep.
+func (g entitlementGrants) without(remove, keep entitlementGrants) entitlementGrants {
+	result := newEntitlementGrants()
+	for entitlementID, values := range g {
+		_, kept := keep[entitlementID]
+		_, removed := remove[entitlementID]
+		for valueID := range values {
+			if keep[entitlementID][valueID] || !remove[entitlementID][valueID] {
+				result.add(entitlementID, valueID)
+			}
+		}
+		if kept || (!removed && len(values) == 0) {
+			result.add(entitlementID)
+		}
+	}
+	return result
+}
+
+func (g entitlementGrants) sortedIDs() []string {
+	ids := make([]string, 0, len(g))
+	for id := range g {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func sortedValueIDs(values map[string]bool) []string {
+	ids := make([]string, 0, len(values))
+	for id := range values {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func (g entitlementGrants) creatable() []governance.EntitlementCreatable {
+	result := make([]governance.EntitlementCreatable, 0, len(g))
+	for _, entitlementID := range g.sortedIDs() {
+		ent := governance.EntitlementCreatable{Id: governance.PtrString(entitlementID)}
+		for _, valueID := range sortedValueIDs(g[entitlementID]) {
+			ent.Values = append(ent.Values, governance.EntitlementValueCreatable{Id: governance.PtrString(valueID)})
+		}
+		result = append(result, ent)
+	}
+	return result
+}
+
+func (g entitlementGrants) blocks() []entitlements {
+	var result []entitlements
+	for _, entitlementID := range g.sortedIDs() {
+		ent := entitlements{Id: types.StringValue(entitlementID)}
+		for _, valueID := range sortedValueIDs(g[entitlementID]) {
+			ent.Values = append(ent.Values, valueBlock{Id: types.StringValue(valueID)})
+		}
+		result = append(result, ent)
+	}
+	return result
+}
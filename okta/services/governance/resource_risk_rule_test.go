@@ -0,0 +1,52 @@
+package governance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccRiskRuleResource_basic(t *testing.T) {
+	acctest.RequireSKU(t, config.SKUGovernance)
+	mgr := newFixtureManager("resources", resources.OktaGovernanceRiskRule, t.Name())
+	tfConfig := mgr.GetFixtures("basic.tf", t)
+	updatedConfig := mgr.GetFixtures("updated.tf", t)
+	resourceName := fmt.Sprintf("%s.test", resources.OktaGovernanceRiskRule)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: tfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Process and approve payment"),
+					resource.TestCheckResourceAttr(resourceName, "type", "SEPARATION_OF_DUTIES"),
+					resource.TestCheckResourceAttr(resourceName, "conflict_criteria.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "conflict_criteria.0.name", "list1"),
+					resource.TestCheckResourceAttr(resourceName, "conflict_criteria.1.operation", "CONTAINS_ONE"),
+					resource.TestCheckResourceAttrPair(resourceName, "conflict_criteria.0.entitlements.0.values.0.id", "okta_entitlement.test", "values.0.id"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Process and approve payment updated"),
+					resource.TestCheckResourceAttr(resourceName, "notes", "Reviewed by finance"),
+					resource.TestCheckResourceAttr(resourceName, "conflict_criteria.1.operation", "CONTAINS_ALL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -23,6 +23,13 @@ type signinPageModel struct {
 	WidgetCustomizations         types.Object `tfsdk:"widget_customizations"`
 }
 
+type errorPageModel struct {
+	ID                           types.String `tfsdk:"id"`
+	BrandID                      types.String `tfsdk:"brand_id"`
+	PageContent                  types.String `tfsdk:"page_content"`
+	ContentSecurityPolicySetting types.Object `tfsdk:"content_security_policy_setting"`
+}
+
 type contentSecurityPolicySettingModel struct {
 	Mode      types.String `tfsdk:"mode"`
 	ReportUri types.String `tfsdk:"report_uri"`
@@ -59,23 +66,7 @@ func mapSignInPageToState(ctx context.Context, data *okta.SignInPage, state *sig
 	state.PageContent = types.StringPointerValue(data.PageContent)
 	state.WidgetVersion = types.StringPointerValue(data.WidgetVersion)
 	if setting, ok := data.GetContentSecurityPolicySettingOk(); ok {
-		srcList := make([]attr.Value, 0)
-		for _, v := range setting.SrcList {
-			srcList = append(srcList, types.StringValue(v))
-		}
-		listValues := types.ListValueMust(types.StringType, srcList)
-		elements := map[string]attr.Value{
-			"src_list":   listValues,
-			"mode":       types.StringPointerValue(setting.Mode),
-			"report_uri": types.StringPointerValue(setting.ReportUri),
-		}
-		elementTypes := map[string]attr.Type{
-			"src_list":   types.ListType{ElemType: types.StringType},
-			"mode":       types.StringType,
-			"report_uri": types.StringType,
-		}
-		settingValue := types.ObjectValueMust(elementTypes, elements)
-		state.ContentSecurityPolicySetting = settingValue
+		state.ContentSecurityPolicySetting = contentSecurityPolicySettingValue(setting)
 	}
 
 	widgetCustomizations := &widgetCustomizationsModel{}
@@ -151,29 +142,82 @@ func buildSignInPageRequest(ctx context.Context, model signinPageModel) (okta.Si
 	sp.SetWidgetCustomizations(wc)
 
 	if !model.ContentSecurityPolicySetting.IsNull() {
-		csp := okta.ContentSecurityPolicySetting{}
-		cspm := &contentSecurityPolicySettingModel{}
-		diags := model.ContentSecurityPolicySetting.As(ctx, cspm, basetypes.ObjectAsOptions{})
+		csp, diags := buildContentSecurityPolicySetting(ctx, model.ContentSecurityPolicySetting)
 		if diags.HasError() {
 			return *okta.NewSignInPage(), diags
 		}
-		csp.Mode = cspm.Mode.ValueStringPointer()
-		csp.ReportUri = cspm.ReportUri.ValueStringPointer()
-		elements := make([]types.String, 0, len(cspm.SrcList.Elements()))
-		diags = cspm.SrcList.ElementsAs(ctx, &elements, false)
+		sp.SetContentSecurityPolicySetting(csp)
+	}
+
+	return sp, nil
+}
+
+func mapErrorPageToState(data *okta.ErrorPage, state *errorPageModel) {
+	state.ID = types.StringValue(state.BrandID.ValueString())
+	state.PageContent = types.StringPointerValue(data.PageContent)
+	if setting, ok := data.GetContentSecurityPolicySettingOk(); ok {
+		state.ContentSecurityPolicySetting = contentSecurityPolicySettingValue(setting)
+	}
+}
+
+func buildErrorPageRequest(ctx context.Context, model errorPageModel) (okta.ErrorPage, diag.Diagnostics) {
+	ep := okta.ErrorPage{}
+	if !model.PageContent.IsNull() {
+		ep.SetPageContent(model.PageContent.ValueString())
+	}
+
+	if !model.ContentSecurityPolicySetting.IsNull() {
+		csp, diags := buildContentSecurityPolicySetting(ctx, model.ContentSecurityPolicySetting)
 		if diags.HasError() {
-			return *okta.NewSignInPage(), diags
+			return *okta.NewErrorPage(), diags
 		}
-		convertElements := make([]string, 0)
-		for _, v := range elements {
-			convertElements = append(convertElements, v.ValueString())
-		}
-		csp.SrcList = convertElements
+		ep.SetContentSecurityPolicySetting(csp)
+	}
 
-		sp.SetContentSecurityPolicySetting(csp)
+	return ep, nil
+}
+
+// contentSecurityPolicySettingValue converts the CSP setting of a sign in or
+// error page into the object value of the content_security_policy_setting block
+func contentSecurityPolicySettingValue(setting *okta.ContentSecurityPolicySetting) types.Object {
+	srcList := make([]attr.Value, 0)
+	for _, v := range setting.SrcList {
+		srcList = append(srcList, types.StringValue(v))
+	}
+	listValues := types.ListValueMust(types.StringType, srcList)
+	elements := map[string]attr.Value{
+		"src_list":   listValues,
+		"mode":       types.StringPointerValue(setting.Mode),
+		"report_uri": types.StringPointerValue(setting.ReportUri),
+	}
+	elementTypes := map[string]attr.Type{
+		"src_list":   types.ListType{ElemType: types.StringType},
+		"mode":       types.StringType,
+		"report_uri": types.StringType,
 	}
+	return types.ObjectValueMust(elementTypes, elements)
+}
 
-	return sp, nil
+func buildContentSecurityPolicySetting(ctx context.Context, setting types.Object) (okta.ContentSecurityPolicySetting, diag.Diagnostics) {
+	csp := okta.ContentSecurityPolicySetting{}
+	cspm := &contentSecurityPolicySettingModel{}
+	diags := setting.As(ctx, cspm, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return csp, diags
+	}
+	csp.Mode = cspm.Mode.ValueStringPointer()
+	csp.ReportUri = cspm.ReportUri.ValueStringPointer()
+	elements := make([]types.String, 0, len(cspm.SrcList.Elements()))
+	diags = cspm.SrcList.ElementsAs(ctx, &elements, false)
+	if diags.HasError() {
+		return csp, diags
+	}
+	convertElements := make([]string, 0)
+	for _, v := range elements {
+		convertElements = append(convertElements, v.ValueString())
+	}
+	csp.SrcList = convertElements
+	return csp, nil
 }
 
 var dataSourceSignInSchema = datasourceSchema.Schema{
@@ -461,3 +505,83 @@ var resourceSignInSchema = resourceSchema.Schema{
 		},
 	},
 }
+
+var dataSourceErrorPageSchema = datasourceSchema.Schema{
+	Attributes: map[string]datasourceSchema.Attribute{
+		"id": datasourceSchema.StringAttribute{
+			Description: "placeholder id",
+			Computed:    true,
+		},
+		"brand_id": datasourceSchema.StringAttribute{
+			Description: "brand id of the error page",
+			Required:    true,
+		},
+		"page_content": datasourceSchema.StringAttribute{
+			Description: "page content of the error page",
+			Computed:    true,
+		},
+	},
+	// NOTED: due to the provider using protocol v5, schema.SingleNestedAttribute is not support and we have to used schema.SingleNestedBlock instead
+	Blocks: map[string]datasourceSchema.Block{
+		"content_security_policy_setting": datasourceSchema.SingleNestedBlock{
+			Description: "",
+			Attributes: map[string]datasourceSchema.Attribute{
+				"mode": datasourceSchema.StringAttribute{
+					Description: "enforced or report_only",
+					Computed:    true,
+				},
+				"report_uri": datasourceSchema.StringAttribute{
+					Description: "",
+					Computed:    true,
+				},
+				"src_list": datasourceSchema.ListAttribute{
+					Description: "",
+					Computed:    true,
+					ElementType: types.StringType,
+				},
+			},
+		},
+	},
+}
+
+var resourceErrorPageSchema = resourceSchema.Schema{
+	Attributes: map[string]resourceSchema.Attribute{
+		"id": resourceSchema.StringAttribute{
+			Description: "placeholder id",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"brand_id": resourceSchema.StringAttribute{
+			Description: "brand id of the error page",
+			Required:    true,
+		},
+		"page_content": resourceSchema.StringAttribute{
+			Description: "page content of the error page",
+			Required:    true,
+		},
+	},
+
+	// NOTED: due to the provider using protocol v5, schema.SingleNestedAttribute is not support and we have to used schema.SingleNestedBlock instead
+	Blocks: map[string]resourceSchema.Block{
+		"content_security_policy_setting": resourceSchema.SingleNestedBlock{
+			Description: "",
+			Attributes: map[string]resourceSchema.Attribute{
+				"mode": resourceSchema.StringAttribute{
+					Description: "enforced or report_only",
+					Optional:    true,
+				},
+				"report_uri": resourceSchema.StringAttribute{
+					Description: "",
+					Optional:    true,
+				},
+				"src_list": resourceSchema.ListAttribute{
+					Description: "",
+					Optional:    true,
+					ElementType: types.StringType,
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,71 @@
+package idaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &defaultErrorPageDataSource{}
+	_ datasource.DataSourceWithConfigure = &defaultErrorPageDataSource{}
+)
+
+func newDefaultErrorPageDataSource() datasource.DataSource {
+	return &defaultErrorPageDataSource{}
+}
+
+type defaultErrorPageDataSource struct {
+	*config.Config
+}
+
+func (d *defaultErrorPageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_error_page"
+}
+
+func (d *defaultErrorPageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	newSchema := dataSourceErrorPageSchema
+	newSchema.Description = "Retrieve the default error page of a brand"
+	resp.Schema = newSchema
+}
+
+func (d *defaultErrorPageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Datasource Configure Type",
+			fmt.Sprintf("Expected *Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.Config = config
+}
+
+func (d *defaultErrorPageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data errorPageModel
+
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultErrorPage, _, err := d.OktaIDaaSClient.OktaSDKClientV3().CustomizationAPI.GetDefaultErrorPage(ctx, data.BrandID.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error retrieving default error page",
+			fmt.Sprintf("Error returned: %s", err.Error()),
+		)
+		return
+	}
+
+	mapErrorPageToState(defaultErrorPage, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
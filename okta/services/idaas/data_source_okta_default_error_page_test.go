@@ -0,0 +1,30 @@
+package idaas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccDataSourceOktaDefaultErrorPage_read(t *testing.T) {
+	mgr := newFixtureManager("data-sources", resources.OktaIDaaSDefaultErrorPage, t.Name())
+	resourceName := fmt.Sprintf("data.%s.test", resources.OktaIDaaSDefaultErrorPage)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: mgr.GetFixtures("datasource.tf", t),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "page_content"),
+				),
+			},
+		},
+	})
+}
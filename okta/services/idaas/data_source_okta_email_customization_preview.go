@@ -0,0 +1,123 @@
+package idaas
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-sdk-golang/v4/okta"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+var (
+	_ datasource.DataSource                   = &emailCustomizationPreviewDataSource{}
+	_ datasource.DataSourceWithConfigure      = &emailCustomizationPreviewDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &emailCustomizationPreviewDataSource{}
+)
+
+type emailCustomizationPreviewDataSource struct {
+	*config.Config
+}
+
+type emailCustomizationPreviewModel struct {
+	ID              types.String `tfsdk:"id"`
+	BrandID         types.String `tfsdk:"brand_id"`
+	TemplateName    types.String `tfsdk:"template_name"`
+	CustomizationID types.String `tfsdk:"customization_id"`
+	Language        types.String `tfsdk:"language"`
+	Subject         types.String `tfsdk:"subject"`
+	Body            types.String `tfsdk:"body"`
+}
+
+func newEmailCustomizationPreviewDataSource() datasource.DataSource {
+	return &emailCustomizationPreviewDataSource{}
+}
+
+func (d *emailCustomizationPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_customization_preview"
+}
+
+func (d *emailCustomizationPreviewDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = dataSourceConfiguration(req, resp)
+}
+
+func (d *emailCustomizationPreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Renders an email customization, or the default content of an email template, with the Velocity variables populated from the current user's context. Use it to check a template before it goes live.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the rendered customization, or the template name when the default content is rendered.",
+			},
+			"brand_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the brand.",
+			},
+			"template_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the email template.",
+			},
+			"customization_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the customization to render. The default content of the template is rendered when not set.",
+			},
+			"language": schema.StringAttribute{
+				Optional:    true,
+				Description: "The language of the default content to render, defaults to the current user's language. Conflicts with `customization_id`.",
+			},
+			"subject": schema.StringAttribute{
+				Computed:    true,
+				Description: "The rendered subject.",
+			},
+			"body": schema.StringAttribute{
+				Computed:    true,
+				Description: "The rendered HTML body.",
+			},
+		},
+	}
+}
+
+func (d *emailCustomizationPreviewDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data emailCustomizationPreviewModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.CustomizationID.IsNull() && !data.Language.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("language"), "Conflicting attributes", "language can not be set together with customization_id, the preview uses the language of the customization.")
+	}
+}
+
+func (d *emailCustomizationPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data emailCustomizationPreviewModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.OktaIDaaSClient.OktaSDKClientV3().CustomizationAPI
+	brandID, templateName := data.BrandID.ValueString(), data.TemplateName.ValueString()
+	var preview *okta.EmailPreview
+	var err error
+	if customizationID := data.CustomizationID.ValueString(); customizationID != "" {
+		preview, _, err = client.GetCustomizationPreview(ctx, brandID, templateName, customizationID).Execute()
+		data.ID = types.StringValue(customizationID)
+	} else {
+		previewReq := client.GetEmailDefaultPreview(ctx, brandID, templateName)
+		if language := data.Language.ValueString(); language != "" {
+			previewReq = previewReq.Language(language)
+		}
+		preview, _, err = previewReq.Execute()
+		data.ID = types.StringValue(templateName)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading email customization preview", "Could not render email preview, unexpected error: "+err.Error())
+		return
+	}
+
+	data.Subject = types.StringValue(preview.GetSubject())
+	data.Body = types.StringValue(preview.GetBody())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
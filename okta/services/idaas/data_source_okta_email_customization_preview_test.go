@@ -0,0 +1,37 @@
+package idaas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccDataSourceOktaEmailCustomizationPreview_read(t *testing.T) {
+	mgr := newFixtureManager("data-sources", resources.OktaIDaaSEmailCustomizationPreview, t.Name())
+	config := mgr.GetFixtures("datasource.tf", t)
+	dataSourceName := fmt.Sprintf("data.%s.test", resources.OktaIDaaSEmailCustomizationPreview)
+	defaultDataSourceName := fmt.Sprintf("data.%s.default", resources.OktaIDaaSEmailCustomizationPreview)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		CheckDestroy:             checkResourceEmailCustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "okta_email_customization.forgot_password_en", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "subject"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "body"),
+					resource.TestCheckResourceAttr(defaultDataSourceName, "id", "UserActivation"),
+					resource.TestCheckResourceAttrSet(defaultDataSourceName, "subject"),
+					resource.TestCheckResourceAttrSet(defaultDataSourceName, "body"),
+				),
+			},
+		},
+	})
+}
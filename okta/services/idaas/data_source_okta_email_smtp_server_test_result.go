@@ -0,0 +1,116 @@
+package idaas
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v5okta "github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+const emailSMTPServerTestNotSent = "NOT_SENT"
+
+var (
+	_ datasource.DataSource              = &emailSMTPServerTestResultDataSource{}
+	_ datasource.DataSourceWithConfigure = &emailSMTPServerTestResultDataSource{}
+)
+
+type emailSMTPServerTestResultDataSource struct {
+	*config.Config
+}
+
+type emailSMTPServerTestResultModel struct {
+	ID            types.String `tfsdk:"id"`
+	ServerID      types.String `tfsdk:"server_id"`
+	From          types.String `tfsdk:"from"`
+	To            types.String `tfsdk:"to"`
+	SendTestEmail types.Bool   `tfsdk:"send_test_email"`
+	Status        types.String `tfsdk:"status"`
+	Error         types.String `tfsdk:"error"`
+	TestedAt      types.String `tfsdk:"tested_at"`
+}
+
+func newEmailSMTPServerTestResultDataSource() datasource.DataSource {
+	return &emailSMTPServerTestResultDataSource{}
+}
+
+func (d *emailSMTPServerTestResultDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_smtp_server_test_result"
+}
+
+func (d *emailSMTPServerTestResultDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = dataSourceConfiguration(req, resp)
+}
+
+func (d *emailSMTPServerTestResultDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Test the connection of an email SMTP server. Okta does not keep the result of previous tests, so the test email is sent when this data source is read, which includes every plan and refresh. " +
+			"No email is sent unless `send_test_email` is `true`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the SMTP server.",
+			},
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the SMTP server.",
+			},
+			"from": schema.StringAttribute{
+				Required:    true,
+				Description: "Email address that sends the test email.",
+			},
+			"to": schema.StringAttribute{
+				Required:    true,
+				Description: "Email address that receives the test email.",
+			},
+			"send_test_email": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Send the test email when the data source is read. Terraform reads data sources during plan, so every plan and refresh sends an email while this is `true`. Default: `false`.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Result of the test - `SUCCESS`, `FAILED`, or `NOT_SENT` when `send_test_email` is not `true`.",
+			},
+			"error": schema.StringAttribute{
+				Computed:    true,
+				Description: "The error returned by Okta when the test failed.",
+			},
+			"tested_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the test, in RFC 3339 format. Not set when no email was sent.",
+			},
+		},
+	}
+}
+
+func (d *emailSMTPServerTestResultDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data emailSMTPServerTestResultModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.ServerID
+	data.Error = types.StringValue("")
+	data.TestedAt = types.StringNull()
+	if !data.SendTestEmail.ValueBool() {
+		data.Status = types.StringValue(emailSMTPServerTestNotSent)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	_, err := d.OktaIDaaSClient.OktaSDKClientV5().EmailServerAPI.TestEmailServer(ctx, data.ServerID.ValueString()).
+		EmailTestAddresses(*v5okta.NewEmailTestAddresses(data.From.ValueString(), data.To.ValueString())).
+		Execute()
+	data.TestedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	// a failed test does not fail the read, the result is in status
+	data.Status = types.StringValue("SUCCESS")
+	if err != nil {
+		data.Status = types.StringValue("FAILED")
+		data.Error = types.StringValue(err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,32 @@
+package idaas_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccDataSourceOktaEmailSMTPServerTestResult_read(t *testing.T) {
+	mgr := newFixtureManager("data-sources", resources.OktaIDaaSEmailSMTPServerTestResult, t.Name())
+	config := mgr.GetFixtures("datasource.tf", t)
+	dataSourceName := "data." + resources.OktaIDaaSEmailSMTPServerTestResult + ".test"
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "okta_email_smtp_server.test", "id"),
+					resource.TestMatchResourceAttr(dataSourceName, "status", regexp.MustCompile(`^(SUCCESS|FAILED)$`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tested_at"),
+				),
+			},
+		},
+	})
+}
@@ -51,13 +51,19 @@ func dataSourceEmailSMTPServersRead(ctx context.Context, d *schema.ResourceData,
 	if !ok {
 		return diag.Errorf("id required for email SMTP servers")
 	}
-	emailSMTPServers, _, _ := getOktaV5ClientFromMetadata(meta).EmailServerAPI.GetEmailServer(ctx, emailSMTPServerId.(string)).Execute()
-	properties := emailSMTPServers.AdditionalProperties
+	emailSMTPServers, _, err := getOktaV5ClientFromMetadata(meta).EmailServerAPI.GetEmailServer(ctx, emailSMTPServerId.(string)).Execute()
+	if err != nil {
+		return diag.Errorf("failed to get email SMTP server: %v", err)
+	}
+	server, err := emailSMTPServerFromResponse(emailSMTPServers)
+	if err != nil {
+		return diag.Errorf("failed to read email SMTP server: %v", err)
+	}
 	d.SetId(emailSMTPServerId.(string))
-	_ = d.Set("host", properties["host"].(string))
-	_ = d.Set("alias", properties["alias"].(string))
-	_ = d.Set("enabled", properties["enabled"].(bool))
-	_ = d.Set("username", properties["username"].(string))
-	_ = d.Set("port", properties["port"].(float64))
+	_ = d.Set("host", server.GetHost())
+	_ = d.Set("alias", server.GetAlias())
+	_ = d.Set("enabled", server.GetEnabled())
+	_ = d.Set("username", server.GetUsername())
+	_ = d.Set("port", server.GetPort())
 	return nil
 }
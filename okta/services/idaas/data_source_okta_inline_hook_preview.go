@@ -0,0 +1,258 @@
+package idaas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v5okta "github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+// inlineHookCommandTypes are the command types an inline hook of a given type
+// may return, see https://developer.okta.com/docs/concepts/inline-hooks/
+var inlineHookCommandTypes = map[string][]string{
+	"com.okta.oauth2.tokens.transform": {
+		"com.okta.identity.patch",
+		"com.okta.access.patch",
+	},
+	"com.okta.saml.tokens.transform": {
+		"com.okta.assertion.patch",
+	},
+	"com.okta.import.transform": {
+		"com.okta.action.update",
+		"com.okta.appUser.profile.update",
+		"com.okta.user.profile.update",
+		"com.okta.user.update",
+	},
+	"com.okta.user.pre-registration": {
+		"com.okta.action.update",
+		"com.okta.user.profile.update",
+		"com.okta.user.progressive.profile.update",
+	},
+	"com.okta.user.credential.password.import": {
+		"com.okta.action.update",
+	},
+	"com.okta.telephony.provider": {
+		"com.okta.telephony.action",
+	},
+}
+
+var (
+	_ datasource.DataSource              = &inlineHookPreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &inlineHookPreviewDataSource{}
+)
+
+type inlineHookPreviewDataSource struct {
+	*config.Config
+}
+
+type inlineHookPreviewModel struct {
+	ID               types.String               `tfsdk:"id"`
+	InlineHookID     types.String               `tfsdk:"inline_hook_id"`
+	PayloadJSON      types.String               `tfsdk:"payload_json"`
+	ValidateCommands types.Bool                 `tfsdk:"validate_commands"`
+	Type             types.String               `tfsdk:"type"`
+	Commands         []inlineHookPreviewCommand `tfsdk:"commands"`
+	ErrorSummary     types.String               `tfsdk:"error_summary"`
+	ResponseJSON     types.String               `tfsdk:"response_json"`
+}
+
+type inlineHookPreviewCommand struct {
+	Type      types.String `tfsdk:"type"`
+	ValueJSON types.String `tfsdk:"value_json"`
+}
+
+// inlineHookPreviewResponse is the response of an inline hook execution. The
+// SDK InlineHookResponse models command values as patch operations with string
+// values, but depending on the hook type a value is an object or holds nested
+// values, so the values are kept raw here.
+type inlineHookPreviewResponse struct {
+	Commands     []*inlineHookPreviewResponseCommand `json:"commands,omitempty"`
+	Error        *inlineHookPreviewResponseError     `json:"error,omitempty"`
+	DebugContext map[string]interface{}              `json:"debugContext,omitempty"`
+}
+
+type inlineHookPreviewResponseCommand struct {
+	Type  string          `json:"type,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type inlineHookPreviewResponseError struct {
+	ErrorSummary string                   `json:"errorSummary,omitempty"`
+	ErrorCauses  []map[string]interface{} `json:"errorCauses,omitempty"`
+}
+
+func newInlineHookPreviewDataSource() datasource.DataSource {
+	return &inlineHookPreviewDataSource{}
+}
+
+func (d *inlineHookPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inline_hook_preview"
+}
+
+func (d *inlineHookPreviewDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = dataSourceConfiguration(req, resp)
+}
+
+func (d *inlineHookPreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends a sample payload through an inline hook and returns the commands of the hook's response. " +
+			"Okta does not act on the commands, this is meant for testing hook contracts without triggering real logins or registrations.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the inline hook.",
+			},
+			"inline_hook_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the inline hook to execute.",
+			},
+			"payload_json": schema.StringAttribute{
+				Required:    true,
+				Description: "The sample request Okta sends to the hook, as JSON. Its shape depends on the hook type.",
+			},
+			"validate_commands": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Fail when the response contains a command type that is not legal for the hook type. Defaults to `true`.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the inline hook.",
+			},
+			"commands": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The commands of the hook's response.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The command type, e.g. `com.okta.identity.patch`.",
+						},
+						"value_json": schema.StringAttribute{
+							Computed:    true,
+							Description: "The value of the command as JSON.",
+						},
+					},
+				},
+			},
+			"error_summary": schema.StringAttribute{
+				Computed:    true,
+				Description: "The error summary when the hook responded with an error object.",
+			},
+			"response_json": schema.StringAttribute{
+				Computed:    true,
+				Description: "The complete response of the hook as JSON.",
+			},
+		},
+	}
+}
+
+func (d *inlineHookPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data inlineHookPreviewModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(data.PayloadJSON.ValueString()), &payload); err != nil {
+		resp.Diagnostics.AddError("Invalid payload_json", "payload_json must be a valid JSON object: "+err.Error())
+		return
+	}
+
+	hook, _, err := d.OktaIDaaSClient.OktaSDKClientV2().InlineHook.GetInlineHook(ctx, data.InlineHookID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading inline hook", "Could not read inline hook, unexpected error: "+err.Error())
+		return
+	}
+
+	_, apiResp, err := d.OktaIDaaSClient.OktaSDKClientV5().InlineHookAPI.ExecuteInlineHook(ctx, hook.Id).PayloadData(payload).Execute()
+	preview, err := inlineHookPreviewFromResponse(apiResp, err)
+	if err != nil {
+		resp.Diagnostics.AddError("Error executing inline hook", "Could not execute inline hook, unexpected error: "+err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(hook.Id)
+	data.Type = types.StringValue(hook.Type)
+	data.Commands = []inlineHookPreviewCommand{}
+	commandTypes := make([]string, 0, len(preview.Commands))
+	for _, command := range preview.Commands {
+		if command == nil {
+			continue
+		}
+		data.Commands = append(data.Commands, inlineHookPreviewCommand{
+			Type:      types.StringValue(command.Type),
+			ValueJSON: types.StringValue(string(command.Value)),
+		})
+		commandTypes = append(commandTypes, command.Type)
+	}
+	data.ErrorSummary = types.StringNull()
+	if preview.Error != nil {
+		data.ErrorSummary = types.StringValue(preview.Error.ErrorSummary)
+	}
+	response, err := json.Marshal(preview)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading inline hook response", err.Error())
+		return
+	}
+	data.ResponseJSON = types.StringValue(string(response))
+
+	if data.ValidateCommands.IsNull() || data.ValidateCommands.ValueBool() {
+		if _, ok := inlineHookCommandTypes[hook.Type]; !ok {
+			resp.Diagnostics.AddWarning(
+				"Inline hook commands not validated",
+				fmt.Sprintf("The legal commands of inline hook type %q are unknown, the response commands were not validated.", hook.Type),
+			)
+		} else if err := ValidateInlineHookCommands(hook.Type, commandTypes); err != nil {
+			resp.Diagnostics.AddError("Invalid inline hook response", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// inlineHookPreviewFromResponse decodes the hook response from the body of an
+// inline hook execution. The SDK fails to decode command values that are not
+// string patch operations, although the call succeeded.
+func inlineHookPreviewFromResponse(apiResp *v5okta.APIResponse, err error) (*inlineHookPreviewResponse, error) {
+	if apiResp == nil || apiResp.Response == nil || apiResp.StatusCode >= http.StatusMultipleChoices {
+		return nil, err
+	}
+	var preview inlineHookPreviewResponse
+	if err := json.NewDecoder(apiResp.Body).Decode(&preview); err != nil {
+		return nil, fmt.Errorf("failed to decode inline hook response: %w", err)
+	}
+	return &preview, nil
+}
+
+// ValidateInlineHookCommands checks that every command type of a hook response
+// is legal for the hook type. Hook types without known commands are not
+// checked.
+func ValidateInlineHookCommands(hookType string, commandTypes []string) error {
+	legal, ok := inlineHookCommandTypes[hookType]
+	if !ok {
+		return nil
+	}
+	var illegal []string
+	for _, commandType := range commandTypes {
+		if !slices.Contains(legal, commandType) && !slices.Contains(illegal, commandType) {
+			illegal = append(illegal, commandType)
+		}
+	}
+	if len(illegal) == 0 {
+		return nil
+	}
+	sort.Strings(illegal)
+	return fmt.Errorf("command types %s are not legal for inline hook type %s, expected one of %s",
+		strings.Join(illegal, ", "), hookType, strings.Join(legal, ", "))
+}
@@ -0,0 +1,57 @@
+package idaas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+	"github.com/okta/terraform-provider-okta/okta/services/idaas"
+)
+
+func TestAccDataSourceOktaInlineHookPreview_read(t *testing.T) {
+	mgr := newFixtureManager("data-sources", resources.OktaIDaaSInlineHookPreview, t.Name())
+	resourceName := fmt.Sprintf("data.%s.test", resources.OktaIDaaSInlineHookPreview)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: mgr.GetFixtures("datasource.tf", t),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "okta_inline_hook.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "com.okta.oauth2.tokens.transform"),
+					resource.TestCheckResourceAttrSet(resourceName, "commands.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "response_json"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateInlineHookCommands(t *testing.T) {
+	tests := []struct {
+		name         string
+		hookType     string
+		commandTypes []string
+		wantErr      bool
+	}{
+		{"no commands", "com.okta.oauth2.tokens.transform", nil, false},
+		{"token patches", "com.okta.oauth2.tokens.transform", []string{"com.okta.identity.patch", "com.okta.access.patch"}, false},
+		{"assertion patch on token hook", "com.okta.oauth2.tokens.transform", []string{"com.okta.assertion.patch"}, true},
+		{"registration action", "com.okta.user.pre-registration", []string{"com.okta.action.update"}, false},
+		{"token patch on registration hook", "com.okta.user.pre-registration", []string{"com.okta.identity.patch"}, true},
+		{"unknown hook type", "com.example.hook", []string{"com.example.command"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := idaas.ValidateInlineHookCommands(tt.hookType, tt.commandTypes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateInlineHookCommands() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
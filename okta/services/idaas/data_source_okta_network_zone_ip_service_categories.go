@@ -0,0 +1,73 @@
+package idaas
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+var (
+	_ datasource.DataSource              = &networkZoneIPServiceCategoriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &networkZoneIPServiceCategoriesDataSource{}
+)
+
+type networkZoneIPServiceCategoriesDataSource struct {
+	*config.Config
+}
+
+type networkZoneIPServiceCategoriesModel struct {
+	ID         types.String `tfsdk:"id"`
+	Categories types.List   `tfsdk:"categories"`
+}
+
+func newNetworkZoneIPServiceCategoriesDataSource() datasource.DataSource {
+	return &networkZoneIPServiceCategoriesDataSource{}
+}
+
+func (d *networkZoneIPServiceCategoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_zone_ip_service_categories"
+}
+
+func (d *networkZoneIPServiceCategoriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = dataSourceConfiguration(req, resp)
+}
+
+func (d *networkZoneIPServiceCategoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the IP service categories that can be used in `ip_service_categories_include` and `ip_service_categories_exclude` of a `DYNAMIC_V2` network zone. " +
+			"The list is static and bundled with the provider, the Okta API is not queried, so categories added to Okta after the provider release are missing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this data source.",
+			},
+			"categories": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IP service categories, e.g. `ALL_ANONYMIZERS` or `NORD_VPN`.",
+			},
+		},
+	}
+}
+
+func (d *networkZoneIPServiceCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data networkZoneIPServiceCategoriesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Okta has no endpoint listing the categories, they are the values the
+	// API documents for IPServiceCategory
+	categories, diags := types.ListValueFrom(ctx, types.StringType, networkZoneIPServiceCategories)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = types.StringValue("ip_service_categories")
+	data.Categories = categories
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,32 @@
+package idaas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccDataSourceOktaNetworkZoneIPServiceCategories_read(t *testing.T) {
+	mgr := newFixtureManager("data-sources", resources.OktaIDaaSNetworkZoneIPServiceCategories, t.Name())
+	config := mgr.GetFixtures("datasource.tf", t)
+	dataSourceName := fmt.Sprintf("data.%s.test", resources.OktaIDaaSNetworkZoneIPServiceCategories)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "ip_service_categories"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "categories.*", "ALL_IP_SERVICES"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "categories.*", "SURFSHARK_VPN"),
+				),
+			},
+		},
+	})
+}
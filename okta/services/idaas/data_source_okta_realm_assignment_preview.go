@@ -0,0 +1,297 @@
+package idaas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v5okta "github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+)
+
+var (
+	_ datasource.DataSource              = &realmAssignmentPreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &realmAssignmentPreviewDataSource{}
+)
+
+type realmAssignmentPreviewDataSource struct {
+	*config.Config
+}
+
+type realmAssignmentPreviewModel struct {
+	ID              types.String                      `tfsdk:"id"`
+	Search          types.String                      `tfsdk:"search"`
+	AssignmentIDs   types.List                        `tfsdk:"assignment_ids"`
+	ProfileSourceID types.String                      `tfsdk:"profile_source_id"`
+	ChangedOnly     types.Bool                        `tfsdk:"changed_only"`
+	Users           []realmAssignmentPreviewUserModel `tfsdk:"users"`
+}
+
+type realmAssignmentPreviewUserModel struct {
+	UserID         types.String `tfsdk:"user_id"`
+	Login          types.String `tfsdk:"login"`
+	CurrentRealmID types.String `tfsdk:"current_realm_id"`
+	RealmID        types.String `tfsdk:"realm_id"`
+	AssignmentID   types.String `tfsdk:"assignment_id"`
+	Changed        types.Bool   `tfsdk:"changed"`
+}
+
+func newRealmAssignmentPreviewDataSource() datasource.DataSource {
+	return &realmAssignmentPreviewDataSource{}
+}
+
+func (d *realmAssignmentPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_realm_assignment_preview"
+}
+
+func (d *realmAssignmentPreviewDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.Config = dataSourceConfiguration(req, resp)
+}
+
+func (d *realmAssignmentPreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Previews the realm each user would be assigned to by realm assignments, without changing anything. " +
+			"The condition expressions are evaluated by the provider in priority order, the default assignment last, so only the common part of the Okta Expression Language is supported: " +
+			"comparisons, `&&`, `||`, `!`, the `contains`, `startsWith`, `endsWith`, `equals`, `equalsIgnoreCase`, `toLowerCase` and `toUpperCase` methods and the `String` and `Arrays` functions of the same names. " +
+			"Users are assumed to come from the profile source of every evaluated assignment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this data source.",
+			},
+			"search": schema.StringAttribute{
+				Optional:    true,
+				Description: "Search expression selecting the users to preview, e.g. `profile.department eq \"Sales\"`. All users are previewed when not set.",
+			},
+			"assignment_ids": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the realm assignments to evaluate, including inactive ones. All active realm assignments are evaluated when not set.",
+			},
+			"profile_source_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only evaluate the realm assignments of this profile source.",
+			},
+			"changed_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only report the users whose realm would change. Default: `false`.",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The resulting realm of each user.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the user.",
+						},
+						"login": schema.StringAttribute{
+							Computed:    true,
+							Description: "The login of the user.",
+						},
+						"current_realm_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the realm the user is in.",
+						},
+						"realm_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the realm the user would be assigned to, the current realm when no assignment matches.",
+						},
+						"assignment_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the realm assignment that matches the user, empty when none does.",
+						},
+						"changed": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the user would move to another realm.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *realmAssignmentPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data realmAssignmentPreviewModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var assignmentIDs []string
+	resp.Diagnostics.Append(data.AssignmentIDs.ElementsAs(ctx, &assignmentIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.OktaIDaaSClient.OktaSDKClientV5()
+	assignments, err := listRealmAssignments(ctx, client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing realm assignments", err.Error())
+		return
+	}
+	var evaluated []v5okta.RealmAssignment
+	for _, assignment := range assignments {
+		if len(assignmentIDs) > 0 {
+			if !slices.Contains(assignmentIDs, assignment.GetId()) {
+				continue
+			}
+		} else if assignment.GetStatus() != "ACTIVE" {
+			continue
+		}
+		if sourceID := data.ProfileSourceID.ValueString(); sourceID != "" && realmAssignmentProfileSourceID(assignment) != sourceID {
+			continue
+		}
+		evaluated = append(evaluated, assignment)
+	}
+	evaluated = OrderRealmAssignments(evaluated)
+
+	users, err := listRealmUsers(ctx, client, data.Search.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing users", err.Error())
+		return
+	}
+
+	data.Users = []realmAssignmentPreviewUserModel{}
+	for _, user := range users {
+		attrs, err := realmExpressionUser(user)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading user "+user.GetId(), err.Error())
+			return
+		}
+		match, err := MatchRealmAssignment(evaluated, attrs)
+		if err != nil {
+			resp.Diagnostics.AddError("Error evaluating realm assignments", fmt.Sprintf("Could not evaluate the realm assignments for user %s: %v", user.GetId(), err))
+			return
+		}
+		result := realmAssignmentPreviewUserModel{
+			UserID:         types.StringValue(user.GetId()),
+			Login:          types.StringValue(user.Profile.GetLogin()),
+			CurrentRealmID: types.StringValue(user.GetRealmId()),
+			RealmID:        types.StringValue(user.GetRealmId()),
+			AssignmentID:   types.StringValue(""),
+			Changed:        types.BoolValue(false),
+		}
+		if match != nil {
+			realmID := match.Actions.AssignUserToRealm.GetRealmId()
+			result.RealmID = types.StringValue(realmID)
+			result.AssignmentID = types.StringValue(match.GetId())
+			result.Changed = types.BoolValue(realmID != user.GetRealmId())
+		}
+		if data.ChangedOnly.ValueBool() && !result.Changed.ValueBool() {
+			continue
+		}
+		data.Users = append(data.Users, result)
+	}
+
+	data.ID = types.StringValue("realm_assignment_preview")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// OrderRealmAssignments returns the realm assignments in the order Okta
+// evaluates them: by priority, the lowest number first, and the default
+// assignment last.
+func OrderRealmAssignments(assignments []v5okta.RealmAssignment) []v5okta.RealmAssignment {
+	ordered := slices.Clone(assignments)
+	slices.SortStableFunc(ordered, func(a, b v5okta.RealmAssignment) int {
+		if a.GetIsDefault() != b.GetIsDefault() {
+			if a.GetIsDefault() {
+				return 1
+			}
+			return -1
+		}
+		return int(a.GetPriority()) - int(b.GetPriority())
+	})
+	return ordered
+}
+
+// MatchRealmAssignment returns the first of the ordered realm assignments
+// whose condition expression matches the user, nil when none does.
+func MatchRealmAssignment(ordered []v5okta.RealmAssignment, user map[string]interface{}) (*v5okta.RealmAssignment, error) {
+	for i, assignment := range ordered {
+		if assignment.Actions == nil || assignment.Actions.AssignUserToRealm == nil {
+			continue
+		}
+		var expression string
+		if assignment.Conditions != nil && assignment.Conditions.Expression != nil {
+			expression = assignment.Conditions.Expression.GetValue()
+		}
+		ok, err := EvaluateRealmAssignmentExpression(expression, user)
+		if err != nil {
+			return nil, fmt.Errorf("realm assignment %q: %w", assignment.GetName(), err)
+		}
+		if ok {
+			return &ordered[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func realmAssignmentProfileSourceID(assignment v5okta.RealmAssignment) string {
+	if assignment.Conditions == nil {
+		return ""
+	}
+	return assignment.Conditions.GetProfileSourceId()
+}
+
+func listRealmAssignments(ctx context.Context, client *v5okta.APIClient) ([]v5okta.RealmAssignment, error) {
+	assignments, resp, err := client.RealmAssignmentAPI.ListRealmAssignments(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	for resp.HasNextPage() {
+		var more []v5okta.RealmAssignment
+		resp, err = resp.Next(&more)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, more...)
+	}
+	return assignments, nil
+}
+
+func listRealmUsers(ctx context.Context, client *v5okta.APIClient, search string) ([]v5okta.User, error) {
+	req := client.UserAPI.ListUsers(ctx).Limit(int32(utils.DefaultPaginationLimit))
+	if search != "" {
+		req = req.Search(search)
+	}
+	users, resp, err := req.Execute()
+	if err != nil {
+		return nil, err
+	}
+	for resp.HasNextPage() {
+		var more []v5okta.User
+		resp, err = resp.Next(&more)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, more...)
+	}
+	return users, nil
+}
+
+// realmExpressionUser returns the attributes of a user the way condition
+// expressions refer to them.
+func realmExpressionUser(user v5okta.User) (map[string]interface{}, error) {
+	profile := map[string]interface{}{}
+	if user.Profile != nil {
+		b, err := json.Marshal(user.Profile)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &profile); err != nil {
+			return nil, err
+		}
+	}
+	return map[string]interface{}{
+		"id":      user.GetId(),
+		"status":  user.GetStatus(),
+		"profile": profile,
+	}, nil
+}
@@ -0,0 +1,139 @@
+package idaas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v5okta "github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+	"github.com/okta/terraform-provider-okta/okta/services/idaas"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+)
+
+func TestAccDataSourceOktaRealmAssignmentPreview_read(t *testing.T) {
+	mgr := newFixtureManager("data-sources", resources.OktaIDaaSRealmAssignmentPreview, t.Name())
+	config := mgr.GetFixtures("datasource.tf", t)
+	dataSourceName := fmt.Sprintf("data.%s.test", resources.OktaIDaaSRealmAssignmentPreview)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "users.0.user_id", "okta_user.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "users.0.realm_id", "okta_realm.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "users.0.assignment_id", "okta_realm_assignment.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.changed", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestEvaluateRealmAssignmentExpression(t *testing.T) {
+	user := map[string]interface{}{
+		"id":     "00u1",
+		"status": "ACTIVE",
+		"profile": map[string]interface{}{
+			"login":      "jane@example.com",
+			"department": "Sales",
+			"title":      "Manager",
+			"level":      float64(3),
+			"groups":     []interface{}{"a", "b"},
+		},
+	}
+	tests := []struct {
+		expression string
+		expected   bool
+		err        bool
+	}{
+		{expression: "", expected: true},
+		{expression: `user.profile.department == "Sales"`, expected: true},
+		{expression: `user.profile.department != "Sales"`, expected: false},
+		{expression: `user.department == 'Sales'`, expected: true},
+		{expression: `user.profile.login.contains("@example.com")`, expected: true},
+		{expression: `user.profile.login.endsWith("@acctest.com")`, expected: false},
+		{expression: `String.startsWith(user.profile.login, "jane")`, expected: true},
+		{expression: `String.stringContains(user.profile.title, "Manag") AND user.status == "ACTIVE"`, expected: true},
+		{expression: `user.profile.title.toLowerCase() == "manager" && !(user.profile.level < 2)`, expected: true},
+		{expression: `user.profile.level >= 4 || user.profile.missing == null`, expected: true},
+		{expression: `user.profile.missing.contains("x")`, expected: false},
+		{expression: `Arrays.contains(user.profile.groups, "b")`, expected: true},
+		{expression: `user.profile.title == "Foo"`, expected: false},
+		{expression: `user.profile.title`, err: true},
+		{expression: `isMemberOfGroupName("Sales")`, err: true},
+		{expression: `user.profile.title.matches(".*")`, err: true},
+		{expression: `user.profile.title == "Foo`, err: true},
+		{expression: `user.profile.title == "Foo" &&`, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			got, err := idaas.EvaluateRealmAssignmentExpression(tt.expression, user)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMatchRealmAssignment(t *testing.T) {
+	assignment := func(id string, priority int32, isDefault bool, expression string) v5okta.RealmAssignment {
+		return v5okta.RealmAssignment{
+			Id:        utils.StringPtr(id),
+			Priority:  &priority,
+			IsDefault: &isDefault,
+			Actions: &v5okta.Actions{AssignUserToRealm: &v5okta.AssignUserToRealm{
+				RealmId: utils.StringPtr("realm-" + id),
+			}},
+			Conditions: &v5okta.Conditions{Expression: &v5okta.Expression{Value: utils.StringPtr(expression)}},
+		}
+	}
+	ordered := idaas.OrderRealmAssignments([]v5okta.RealmAssignment{
+		assignment("default", 0, true, ""),
+		assignment("sales", 20, false, `user.profile.department == "Sales"`),
+		assignment("managers", 10, false, `user.profile.title == "Manager"`),
+	})
+	for i, id := range []string{"managers", "sales", "default"} {
+		if ordered[i].GetId() != id {
+			t.Fatalf("expected %s at position %d, got %s", id, i, ordered[i].GetId())
+		}
+	}
+
+	tests := []struct {
+		profile  map[string]interface{}
+		expected string
+	}{
+		{profile: map[string]interface{}{"department": "Sales", "title": "Manager"}, expected: "managers"},
+		{profile: map[string]interface{}{"department": "Sales"}, expected: "sales"},
+		{profile: map[string]interface{}{"department": "IT"}, expected: "default"},
+	}
+	for _, tt := range tests {
+		match, err := idaas.MatchRealmAssignment(ordered, map[string]interface{}{"profile": tt.profile})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if match == nil || match.GetId() != tt.expected {
+			t.Errorf("expected %s for %v, got %v", tt.expected, tt.profile, match)
+		}
+	}
+
+	match, err := idaas.MatchRealmAssignment(ordered[:2], map[string]interface{}{"profile": map[string]interface{}{}})
+	if err != nil || match != nil {
+		t.Errorf("expected no match, got %v, %v", match, err)
+	}
+}
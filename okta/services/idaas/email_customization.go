@@ -88,12 +88,24 @@ var emailCustomizationResourceSchema = map[string]*schema.Schema{
 	"subject": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "The subject of the customization",
+		Computed:    true,
+		Description: "The subject of the customization. Taken from the default content of the template when `from_default_content` is set and `subject` is not.",
 	},
 	"body": {
 		Type:        schema.TypeString,
 		Optional:    true,
-		Description: "The body of the customization",
+		Computed:    true,
+		Description: "The body of the customization. Taken from the default content of the template when `from_default_content` is set and `body` is not.",
+	},
+	"from_default_content": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Seed `subject` and `body` from Okta's default content of the template in the customization's `language`. Values set in `subject` or `body` override the default content, the rest follows `language` when it changes.",
+	},
+	"validate_variables": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Warn at plan time about `${...}` and `$!{...}` variables referenced in `subject` and `body` that the provider does not know for the email template. Defaults to `true`.",
 	},
 	"force_is_default": {
 		Type:        schema.TypeString,
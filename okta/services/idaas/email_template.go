@@ -2,6 +2,11 @@ package idaas
 
 import (
 	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v4/okta"
@@ -39,3 +44,86 @@ func flattenEmailTemplate(emailTemplate *okta.EmailTemplate) map[string]interfac
 
 	return attrs
 }
+
+// emailTemplateVariableNamespaces are the objects available in the Velocity
+// context of every email template, e.g. ${user.profile.firstName} or
+// ${f.escapeHtml(...)}.
+var emailTemplateVariableNamespaces = []string{"app", "brand", "f", "org", "request", "user"}
+
+// emailTemplateGlobalVariables are the top level variables available in every
+// email template.
+var emailTemplateGlobalVariables = []string{"baseURL"}
+
+// emailTemplateVariables are the top level variables specific to an email
+// template. Templates missing here are not validated.
+var emailTemplateVariables = map[string][]string{
+	"AccountLockout":                     {"recoveryToken", "unlockAccountLink"},
+	"ADForgotPassword":                   {"oneTimePassword", "recoveryToken", "resetPasswordLink"},
+	"ADForgotPasswordDenied":             {},
+	"ADSelfServiceUnlock":                {"oneTimePassword", "recoveryToken", "unlockAccountLink"},
+	"ADUserActivation":                   {"activationLink", "activationToken", "samAccountName"},
+	"AuthenticatorEnrolled":              {"authenticator", "factor"},
+	"AuthenticatorReset":                 {"authenticator", "factor"},
+	"ChangeEmailConfirmation":            {"newEmail", "oldEmail", "verificationLink", "verificationToken"},
+	"EmailChallenge":                     {"emailAuthenticationLink", "verificationLink", "verificationToken"},
+	"EmailChangeConfirmation":            {"newEmail", "oldEmail", "verificationLink", "verificationToken"},
+	"EmailFactorVerification":            {"emailAuthenticationLink", "verificationLink", "verificationToken"},
+	"ForgotPassword":                     {"oneTimePassword", "recoveryToken", "resetPasswordLink"},
+	"ForgotPasswordDenied":               {},
+	"LDAPForgotPassword":                 {"oneTimePassword", "recoveryToken", "resetPasswordLink"},
+	"LDAPForgotPasswordDenied":           {},
+	"LDAPSelfServiceUnlock":              {"oneTimePassword", "recoveryToken", "unlockAccountLink"},
+	"LDAPUserActivation":                 {"activationLink", "activationToken"},
+	"NewSignOnNotification":              {},
+	"OktaVerifyActivation":               {"pushVerifyActivationLink"},
+	"PasswordChanged":                    {},
+	"PasswordResetByAdmin":               {"recoveryToken", "resetPasswordLink"},
+	"RegistrationActivation":             {"activationLink", "activationToken", "registrationActivationLink"},
+	"RegistrationEmailVerification":      {"registrationEmailVerificationLink", "verificationLink", "verificationToken"},
+	"SelfServiceUnlock":                  {"oneTimePassword", "recoveryToken", "unlockAccountLink"},
+	"SelfServiceUnlockOnUnlockedAccount": {},
+	"UserActivation":                     {"activationLink", "activationToken"},
+}
+
+var (
+	emailTemplateReferenceRegexp  = regexp.MustCompile(`\$!?\{\s*([A-Za-z_][A-Za-z0-9_]*)`)
+	emailTemplateDefinitionRegexp = regexp.MustCompile(`#(?:set|foreach)\s*\(\s*\$!?\{?\s*([A-Za-z_][A-Za-z0-9_]*)`)
+)
+
+// ValidateEmailTemplateVariables checks that the ${...} references of an email
+// template subject or body are variables the provider knows for the named
+// template. References without braces are not checked.
+// Variables defined with #set or #foreach are allowed, templates without known
+// variables are not validated.
+func ValidateEmailTemplateVariables(templateName, text string) error {
+	known, ok := emailTemplateVariables[templateName]
+	if !ok {
+		return nil
+	}
+	var defined []string
+	for _, match := range emailTemplateDefinitionRegexp.FindAllStringSubmatch(text, -1) {
+		defined = append(defined, match[1])
+	}
+	var unknown []string
+	for _, match := range emailTemplateReferenceRegexp.FindAllStringSubmatch(text, -1) {
+		name := match[1]
+		if slices.Contains(emailTemplateVariableNamespaces, name) ||
+			slices.Contains(emailTemplateGlobalVariables, name) ||
+			slices.Contains(known, name) ||
+			slices.Contains(defined, name) ||
+			slices.Contains(unknown, name) {
+			continue
+		}
+		unknown = append(unknown, name)
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+	available := append(slices.Clone(emailTemplateGlobalVariables), known...)
+	for _, namespace := range emailTemplateVariableNamespaces {
+		available = append(available, namespace+".*")
+	}
+	return fmt.Errorf("variables %s are not available in email template %s, expected one of %s",
+		strings.Join(unknown, ", "), templateName, strings.Join(available, ", "))
+}
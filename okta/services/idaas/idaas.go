@@ -98,13 +98,18 @@ func FWProviderResources() []func() resource.Resource {
 		newPolicyDeviceAssuranceWindowsResource,
 		newCustomizedSigninResource,
 		newPreviewSigninResource,
+		newCustomizedErrorPageResource,
+		newPreviewErrorPageResource,
 		newGroupOwnerResource,
 		newGroupOwnersResource,
 		newAppSignOnPolicyResource,
 		newEmailTemplateSettingsResource,
+		newEmailTemplateTestSendResource,
+		newEmailCustomizationsResource,
 		newFeaturesResource,
 		newRealmResource,
 		newRealmAssignmentResource,
+		newRealmUserMigrationResource,
 		newRateLimitResource,
 		newRateLimitAdminNotificationSettingsResource,
 		newRateLimitWarningThresholdPercentageResource,
@@ -133,6 +138,12 @@ func FWProviderResources() []func() resource.Resource {
 		newIdentitySourceGroupMembershipResource,
 		newIdentitySourceImportResource,
 		newIdentitySourceUserResource,
+		newAppOAuthClientSecretResource,
+		newAppOAuthJwkResource,
+		newAppSamlSigningCsrResource,
+		newAppSamlSigningCertificateResource,
+		newAuthServerKeyRotationResource,
+		newNetworkZoneSetResource,
 	}
 	// Wrap all resources with SafeResource for panic recovery
 	return resources.WrapResources(rawResources)
@@ -144,6 +155,7 @@ func FWProviderDataSources() []func() datasource.DataSource {
 		newAuthServerKeysDataSource,
 		newOrgMetadataDataSource,
 		newDefaultSigninPageDataSource,
+		newDefaultErrorPageDataSource,
 		newLogStreamDataSource,
 		newAppsDataSource,
 		newUserTypeDataSource,
@@ -151,6 +163,7 @@ func FWProviderDataSources() []func() datasource.DataSource {
 		newFeaturesDataSource,
 		newRealmDataSource,
 		newRealmAssignmentDataSource,
+		newRealmAssignmentPreviewDataSource,
 		newRateLimitAdminNotificationSettingsDataSource,
 		newRateLimitWarningThresholdPercentageDataSource,
 		newPrincipalRateLimitsDataSource,
@@ -159,6 +172,10 @@ func FWProviderDataSources() []func() datasource.DataSource {
 		newAppFeaturesDataSource,
 		newPushProviderDataSource,
 		newHookKeyDataSource,
+		newInlineHookPreviewDataSource,
+		newNetworkZoneIPServiceCategoriesDataSource,
+		newEmailCustomizationPreviewDataSource,
+		newEmailSMTPServerTestResultDataSource,
 		newAPIServiceIntegrationDataSource,
 		newAPITokenDataSource,
 		newAppTokenDataSource,
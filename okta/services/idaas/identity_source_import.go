@@ -0,0 +1,318 @@
+package idaas
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// identitySourceImportMaxBatchSize is the maximum number of records the
+	// identity source bulk upload endpoints accept in a single request
+	identitySourceImportMaxBatchSize = 200
+
+	identitySourceImportEntityUsers       = "USERS"
+	identitySourceImportEntityGroups      = "GROUPS"
+	identitySourceImportEntityMemberships = "MEMBERSHIPS"
+
+	identitySourceImportOperationUpsert = "UPSERT"
+	identitySourceImportOperationDelete = "DELETE"
+
+	identitySourceImportFormatCSV   = "CSV"
+	identitySourceImportFormatJSONL = "JSONL"
+)
+
+// IdentitySourceImportRecord is a user or group read from a source file. The
+// profile is empty for deletes.
+type IdentitySourceImportRecord struct {
+	ExternalID string
+	Profile    map[string]interface{}
+}
+
+// IdentitySourceImportMembership is the membership of a group read from a
+// source file.
+type IdentitySourceImportMembership struct {
+	GroupExternalID   string
+	MemberExternalIDs []string
+}
+
+// IdentitySourceImportFile is the content of a source file, Records is set for
+// USERS and GROUPS, Memberships for MEMBERSHIPS.
+type IdentitySourceImportFile struct {
+	Records     []IdentitySourceImportRecord
+	Memberships []IdentitySourceImportMembership
+}
+
+// identitySourceImportCheckpoint is the per-chunk progress of an import kept
+// on disk so a failed apply can resume uploading into the same session.
+type identitySourceImportCheckpoint struct {
+	IdentitySourceID string `json:"identity_source_id"`
+	SessionID        string `json:"session_id"`
+	Fingerprint      string `json:"fingerprint"`
+	ChunksTotal      int    `json:"chunks_total"`
+	ChunksUploaded   int    `json:"chunks_uploaded"`
+}
+
+// IdentitySourceImportFileFormat returns the format of a source file, either
+// the one given or the one implied by the file extension.
+func IdentitySourceImportFileFormat(path, format string) (string, error) {
+	if format != "" {
+		return strings.ToUpper(format), nil
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return identitySourceImportFormatCSV, nil
+	case ".jsonl", ".ndjson":
+		return identitySourceImportFormatJSONL, nil
+	}
+	return "", fmt.Errorf("unable to infer the format of %q from its extension, set format to %s or %s", path, identitySourceImportFormatCSV, identitySourceImportFormatJSONL)
+}
+
+func readIdentitySourceImportFile(path, format, entity, operation string) (*IdentitySourceImportFile, error) {
+	format, err := IdentitySourceImportFileFormat(path, format)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	file, err := ParseIdentitySourceImportFile(f, format, entity, operation)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return file, nil
+}
+
+// ParseIdentitySourceImportFile reads the users, groups or group memberships
+// of a CSV or JSONL source file.
+//
+// CSV files have a header row. Users and groups are identified by an
+// externalId column, every other non-empty column is a profile attribute named
+// after its header. Memberships have one groupExternalId and memberExternalId
+// pair per row.
+//
+// JSONL files have one JSON object per line. Users and groups look like
+// {"externalId": "...", "profile": {...}}, memberships like
+// {"groupExternalId": "...", "memberExternalIds": ["..."]}.
+func ParseIdentitySourceImportFile(r io.Reader, format, entity, operation string) (*IdentitySourceImportFile, error) {
+	var rows []map[string]interface{}
+	var err error
+	switch format {
+	case identitySourceImportFormatCSV:
+		rows, err = readIdentitySourceImportCSV(r)
+	case identitySourceImportFormatJSONL:
+		rows, err = readIdentitySourceImportJSONL(r)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	file := &IdentitySourceImportFile{}
+	if entity == identitySourceImportEntityMemberships {
+		index := map[string]int{}
+		for i, row := range rows {
+			groupID := identitySourceImportString(row, "groupExternalId", "group_external_id")
+			if groupID == "" {
+				return nil, fmt.Errorf("record %d: missing groupExternalId", i+1)
+			}
+			var members []string
+			if member := identitySourceImportString(row, "memberExternalId", "member_external_id"); member != "" {
+				members = append(members, member)
+			}
+			if raw, ok := row["memberExternalIds"].([]interface{}); ok {
+				for _, member := range raw {
+					if s, ok := member.(string); ok && s != "" {
+						members = append(members, s)
+					}
+				}
+			}
+			if len(members) == 0 {
+				return nil, fmt.Errorf("record %d: missing memberExternalId", i+1)
+			}
+			// rows of the same group are merged into one membership
+			if j, ok := index[groupID]; ok {
+				file.Memberships[j].MemberExternalIDs = append(file.Memberships[j].MemberExternalIDs, members...)
+				continue
+			}
+			index[groupID] = len(file.Memberships)
+			file.Memberships = append(file.Memberships, IdentitySourceImportMembership{
+				GroupExternalID:   groupID,
+				MemberExternalIDs: members,
+			})
+		}
+		return file, nil
+	}
+
+	for i, row := range rows {
+		externalID := identitySourceImportString(row, "externalId", "external_id")
+		if externalID == "" {
+			return nil, fmt.Errorf("record %d: missing externalId", i+1)
+		}
+		record := IdentitySourceImportRecord{ExternalID: externalID}
+		if operation != identitySourceImportOperationDelete {
+			record.Profile = map[string]interface{}{}
+			if profile, ok := row["profile"].(map[string]interface{}); ok {
+				record.Profile = profile
+			} else {
+				for k, v := range row {
+					if k == "externalId" || k == "external_id" {
+						continue
+					}
+					record.Profile[k] = v
+				}
+			}
+		}
+		file.Records = append(file.Records, record)
+	}
+	return file, nil
+}
+
+func readIdentitySourceImportCSV(r io.Reader) ([]map[string]interface{}, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff"))
+	}
+	var rows []map[string]interface{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		row := map[string]interface{}{}
+		for i, value := range record {
+			if value == "" {
+				continue
+			}
+			row[header[i]] = value
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func readIdentitySourceImportJSONL(r io.Reader) ([]map[string]interface{}, error) {
+	scanner := bufio.NewScanner(r)
+	// profiles with many attributes can exceed the default 64KB line limit
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var rows []map[string]interface{}
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		row := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(text), &row); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		rows = append(rows, row)
+	}
+	return rows, scanner.Err()
+}
+
+func identitySourceImportString(row map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if s, ok := row[key].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// ChunkIdentitySourceImportMemberships splits memberships into chunks of at
+// most size member IDs, the memberships of large groups span several chunks.
+func ChunkIdentitySourceImportMemberships(memberships []IdentitySourceImportMembership, size int) [][]IdentitySourceImportMembership {
+	var chunks [][]IdentitySourceImportMembership
+	var current []IdentitySourceImportMembership
+	count := 0
+	for _, membership := range memberships {
+		members := membership.MemberExternalIDs
+		for len(members) > 0 {
+			n := min(size-count, len(members))
+			current = append(current, IdentitySourceImportMembership{
+				GroupExternalID:   membership.GroupExternalID,
+				MemberExternalIDs: members[:n],
+			})
+			members = members[n:]
+			count += n
+			if count == size {
+				chunks = append(chunks, current)
+				current = nil
+				count = 0
+			}
+		}
+	}
+	if len(current) > 0 {
+		chunks = append(chunks, current)
+	}
+	return chunks
+}
+
+// identitySourceImportFingerprint identifies the content of an import so a
+// checkpoint is only resumed for the same data.
+func identitySourceImportFingerprint(identitySourceID string, chunks []identitySourceImportChunk) (string, error) {
+	h := sha256.New()
+	h.Write([]byte(identitySourceID))
+	for _, chunk := range chunks {
+		b, err := json.Marshal(chunk.body)
+		if err != nil {
+			return "", err
+		}
+		h.Write([]byte(chunk.kind))
+		h.Write(b)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func readIdentitySourceImportCheckpoint(path string) (*identitySourceImportCheckpoint, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var checkpoint identitySourceImportCheckpoint
+	if err := json.Unmarshal(b, &checkpoint); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint file %s: %w", path, err)
+	}
+	return &checkpoint, nil
+}
+
+// writeIdentitySourceImportCheckpoint replaces the checkpoint file through a
+// rename so an interrupted write never leaves a truncated checkpoint behind.
+func writeIdentitySourceImportCheckpoint(path string, checkpoint *identitySourceImportCheckpoint) error {
+	b, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
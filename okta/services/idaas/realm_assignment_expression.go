@@ -0,0 +1,497 @@
+package idaas
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The realm assignment preview evaluates the condition expressions of realm
+// assignments locally, Okta has no endpoint to dry-run them. Only the part of
+// the Okta Expression Language that realm assignment conditions are written in
+// is supported: comparisons and boolean logic on user attributes, the string
+// methods and the String and Arrays functions below. Anything else is reported
+// as unsupported rather than guessed.
+
+var realmExpressionOperators = map[string]bool{
+	"==": true, "!=": true, ">=": true, "<=": true, "&&": true, "||": true,
+	"!": true, ">": true, "<": true, "(": true, ")": true, ".": true, ",": true,
+}
+
+type realmExpressionToken struct {
+	kind  string // ident, string, number, op, eof
+	value string
+	pos   int
+}
+
+type realmExpressionParser struct {
+	tokens []realmExpressionToken
+	pos    int
+	user   map[string]interface{}
+}
+
+// EvaluateRealmAssignmentExpression evaluates a realm assignment condition
+// expression for a user. The user map holds the user's "profile" map and may
+// hold top level attributes like "id" and "status". An empty expression
+// matches every user.
+func EvaluateRealmAssignmentExpression(expression string, user map[string]interface{}) (bool, error) {
+	if strings.TrimSpace(expression) == "" {
+		return true, nil
+	}
+	tokens, err := tokenizeRealmExpression(expression)
+	if err != nil {
+		return false, err
+	}
+	p := &realmExpressionParser{tokens: tokens, user: user}
+	value, err := p.parseOr()
+	if err != nil {
+		return false, err
+	}
+	if tok := p.peek(); tok.kind != "eof" {
+		return false, fmt.Errorf("unexpected %q at position %d", tok.value, tok.pos)
+	}
+	result, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression %q does not evaluate to a boolean", expression)
+	}
+	return result, nil
+}
+
+func tokenizeRealmExpression(expression string) ([]realmExpressionToken, error) {
+	var tokens []realmExpressionToken
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"' || c == '\'':
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(runes) && runes[j] != c; j++ {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				sb.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", i)
+			}
+			tokens = append(tokens, realmExpressionToken{kind: "string", value: sb.String(), pos: i})
+			i = j + 1
+		case unicode.IsDigit(c):
+			j := i
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, realmExpressionToken{kind: "number", value: string(runes[i:j]), pos: i})
+			i = j
+		case unicode.IsLetter(c) || c == '_' || c == '$':
+			j := i
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_' || runes[j] == '$') {
+				j++
+			}
+			tokens = append(tokens, realmExpressionToken{kind: "ident", value: string(runes[i:j]), pos: i})
+			i = j
+		default:
+			op := string(c)
+			if i+1 < len(runes) {
+				switch two := string(runes[i : i+2]); two {
+				case "==", "!=", ">=", "<=", "&&", "||":
+					op = two
+				}
+			}
+			if !realmExpressionOperators[op] {
+				return nil, fmt.Errorf("unexpected character %q at position %d", op, i)
+			}
+			tokens = append(tokens, realmExpressionToken{kind: "op", value: op, pos: i})
+			i += len([]rune(op))
+		}
+	}
+	return append(tokens, realmExpressionToken{kind: "eof", pos: len(runes)}), nil
+}
+
+func (p *realmExpressionParser) peek() realmExpressionToken {
+	return p.tokens[p.pos]
+}
+
+func (p *realmExpressionParser) next() realmExpressionToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != "eof" {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *realmExpressionParser) accept(values ...string) (string, bool) {
+	tok := p.peek()
+	if tok.kind != "op" && tok.kind != "ident" {
+		return "", false
+	}
+	for _, v := range values {
+		if tok.value == v {
+			p.pos++
+			return v, true
+		}
+	}
+	return "", false
+}
+
+func (p *realmExpressionParser) expect(value string) error {
+	if _, ok := p.accept(value); !ok {
+		tok := p.peek()
+		return fmt.Errorf("expected %q at position %d, found %q", value, tok.pos, tok.value)
+	}
+	return nil
+}
+
+func (p *realmExpressionParser) parseOr() (interface{}, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.accept("||", "OR", "or"); !ok {
+			return left, nil
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l, r, err := realmExpressionBools("||", left, right)
+		if err != nil {
+			return nil, err
+		}
+		left = l || r
+	}
+}
+
+func (p *realmExpressionParser) parseAnd() (interface{}, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.accept("&&", "AND", "and"); !ok {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l, r, err := realmExpressionBools("&&", left, right)
+		if err != nil {
+			return nil, err
+		}
+		left = l && r
+	}
+}
+
+func (p *realmExpressionParser) parseUnary() (interface{}, error) {
+	if _, ok := p.accept("!", "NOT", "not"); ok {
+		value, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operator ! expects a boolean, got %v", value)
+		}
+		return !b, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *realmExpressionParser) parseComparison() (interface{}, error) {
+	left, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	op, ok := p.accept("==", "!=", ">=", "<=", ">", "<")
+	if !ok {
+		return left, nil
+	}
+	right, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	switch op {
+	case "==":
+		return realmExpressionEqual(left, right), nil
+	case "!=":
+		return !realmExpressionEqual(left, right), nil
+	}
+	return realmExpressionCompare(op, left, right)
+}
+
+func (p *realmExpressionParser) parseValue() (interface{}, error) {
+	tok := p.next()
+	var value interface{}
+	switch tok.kind {
+	case "string":
+		value = tok.value
+	case "number":
+		f, err := strconv.ParseFloat(tok.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", tok.value, tok.pos)
+		}
+		value = f
+	case "op":
+		if tok.value != "(" {
+			return nil, fmt.Errorf("unexpected %q at position %d", tok.value, tok.pos)
+		}
+		v, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		value = v
+	case "ident":
+		v, err := p.parseIdentifier(tok)
+		if err != nil {
+			return nil, err
+		}
+		value = v
+	default:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return p.parseMethods(value)
+}
+
+func (p *realmExpressionParser) parseIdentifier(tok realmExpressionToken) (interface{}, error) {
+	switch tok.value {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	case "String", "Arrays":
+		if err := p.expect("."); err != nil {
+			return nil, err
+		}
+		fn := p.next()
+		args, err := p.parseArguments()
+		if err != nil {
+			return nil, err
+		}
+		return realmExpressionFunction(tok.value+"."+fn.value, args)
+	case "user":
+		// user.profile.attr and the shorthand user.attr both refer to profile
+		// attributes, user.id and user.status to the user itself
+		var segments []string
+		for p.peek().kind == "op" && p.peek().value == "." && p.tokens[p.pos+1].kind == "ident" && p.tokens[p.pos+2].value != "(" {
+			p.pos++
+			segments = append(segments, p.next().value)
+		}
+		return realmExpressionUserAttribute(p.user, segments), nil
+	}
+	return nil, fmt.Errorf("unsupported identifier %q at position %d", tok.value, tok.pos)
+}
+
+func (p *realmExpressionParser) parseMethods(value interface{}) (interface{}, error) {
+	for p.peek().kind == "op" && p.peek().value == "." {
+		p.pos++
+		method := p.next()
+		if method.kind != "ident" {
+			return nil, fmt.Errorf("expected a method name at position %d", method.pos)
+		}
+		args, err := p.parseArguments()
+		if err != nil {
+			return nil, err
+		}
+		value, err = realmExpressionFunction("method."+method.value, append([]interface{}{value}, args...))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return value, nil
+}
+
+func (p *realmExpressionParser) parseArguments() ([]interface{}, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var args []interface{}
+	if _, ok := p.accept(")"); ok {
+		return args, nil
+	}
+	for {
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if _, ok := p.accept(","); ok {
+			continue
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return args, nil
+	}
+}
+
+func realmExpressionUserAttribute(user map[string]interface{}, segments []string) interface{} {
+	if len(segments) == 0 {
+		return nil
+	}
+	profile, _ := user["profile"].(map[string]interface{})
+	switch segments[0] {
+	case "profile":
+		if len(segments) != 2 {
+			return nil
+		}
+		return profile[segments[1]]
+	case "id", "status":
+		if len(segments) == 1 {
+			return user[segments[0]]
+		}
+	}
+	if len(segments) != 1 {
+		return nil
+	}
+	return profile[segments[0]]
+}
+
+func realmExpressionFunction(name string, args []interface{}) (interface{}, error) {
+	str := func(i int) (string, bool) {
+		if i >= len(args) {
+			return "", false
+		}
+		s, ok := args[i].(string)
+		return s, ok
+	}
+	arity := map[string]int{
+		"method.contains": 2, "method.startsWith": 2, "method.endsWith": 2, "method.equals": 2,
+		"method.equalsIgnoreCase": 2, "method.toLowerCase": 1, "method.toUpperCase": 1,
+		"String.stringContains": 2, "String.startsWith": 2, "String.endsWith": 2, "String.toLowerCase": 1,
+		"String.toUpperCase": 1, "String.len": 1, "Arrays.contains": 2, "Arrays.isEmpty": 1, "Arrays.size": 1,
+	}
+	n, ok := arity[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported function %s", strings.TrimPrefix(name, "method."))
+	}
+	if len(args) != n {
+		if strings.HasPrefix(name, "method.") {
+			return nil, fmt.Errorf("%s expects %d arguments, got %d", strings.TrimPrefix(name, "method."), n-1, len(args)-1)
+		}
+		return nil, fmt.Errorf("%s expects %d arguments, got %d", name, n, len(args))
+	}
+	a, aok := str(0)
+	b, bok := str(1)
+	switch name {
+	case "method.contains", "String.stringContains":
+		if list, ok := args[0].([]interface{}); ok && name == "method.contains" {
+			return realmExpressionListContains(list, args[1]), nil
+		}
+		return aok && bok && strings.Contains(a, b), nil
+	case "method.startsWith", "String.startsWith":
+		return aok && bok && strings.HasPrefix(a, b), nil
+	case "method.endsWith", "String.endsWith":
+		return aok && bok && strings.HasSuffix(a, b), nil
+	case "method.equals":
+		return realmExpressionEqual(args[0], args[1]), nil
+	case "method.equalsIgnoreCase":
+		return aok && bok && strings.EqualFold(a, b), nil
+	case "method.toLowerCase", "String.toLowerCase":
+		if !aok {
+			return nil, nil
+		}
+		return strings.ToLower(a), nil
+	case "method.toUpperCase", "String.toUpperCase":
+		if !aok {
+			return nil, nil
+		}
+		return strings.ToUpper(a), nil
+	case "String.len":
+		return float64(len([]rune(a))), nil
+	case "Arrays.contains":
+		list, _ := args[0].([]interface{})
+		return realmExpressionListContains(list, args[1]), nil
+	case "Arrays.isEmpty":
+		list, _ := args[0].([]interface{})
+		return len(list) == 0, nil
+	default: // Arrays.size
+		list, _ := args[0].([]interface{})
+		return float64(len(list)), nil
+	}
+}
+
+func realmExpressionListContains(list []interface{}, value interface{}) bool {
+	for _, item := range list {
+		if realmExpressionEqual(item, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func realmExpressionEqual(a, b interface{}) bool {
+	if fa, ok := realmExpressionNumber(a); ok {
+		if fb, ok := realmExpressionNumber(b); ok {
+			return fa == fb
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func realmExpressionNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+func realmExpressionCompare(op string, a, b interface{}) (bool, error) {
+	var cmp int
+	if fa, ok := realmExpressionNumber(a); ok {
+		fb, ok := realmExpressionNumber(b)
+		if !ok {
+			return false, nil
+		}
+		switch {
+		case fa < fb:
+			cmp = -1
+		case fa > fb:
+			cmp = 1
+		}
+	} else if sa, ok := a.(string); ok {
+		sb, ok := b.(string)
+		if !ok {
+			return false, nil
+		}
+		cmp = strings.Compare(sa, sb)
+	} else {
+		// null attributes never compare
+		return false, nil
+	}
+	switch op {
+	case ">":
+		return cmp > 0, nil
+	case ">=":
+		return cmp >= 0, nil
+	case "<":
+		return cmp < 0, nil
+	default:
+		return cmp <= 0, nil
+	}
+}
+
+func realmExpressionBools(op string, left, right interface{}) (bool, bool, error) {
+	l, lok := left.(bool)
+	r, rok := right.(bool)
+	if !lok || !rok {
+		return false, false, fmt.Errorf("operator %s expects booleans, got %v and %v", op, left, right)
+	}
+	return l, r, nil
+}
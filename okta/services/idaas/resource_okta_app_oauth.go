@@ -138,6 +138,12 @@ func resourceAppOAuth() *schema.Resource {
 				if oldValue.(bool) && !newValue.(bool) {
 					return d.ForceNew("omit_secret")
 				}
+				// Same applies when secrets are handed back to this resource
+				// after being managed by okta_app_oauth_client_secret
+				oldValue, newValue = d.GetChange("skip_client_secret")
+				if oldValue.(bool) && !newValue.(bool) && !d.Get("omit_secret").(bool) {
+					return d.ForceNew("skip_client_secret")
+				}
 			}
 			return nil
 		},
@@ -175,6 +181,13 @@ other arguments that changed will be applied.`,
 				Description: "This tells the provider not manage the client_secret value in state. When this is false (the default), it will cause the auto-generated client_secret to be persisted in the client_secret attribute in state. This also means that every time an update to this app is run, this value is also set on the API. If this changes from false => true, the `client_secret` is dropped from state and the secret at the time of the apply is what remains. If this is ever changes from true => false your app will be recreated, due to the need to regenerate a secret we can store in state.",
 				Default:     false,
 			},
+			"skip_client_secret": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "When set to true, the provider does not send, read or store the client secret of this application. Use this when the secrets of the app are managed with `okta_app_oauth_client_secret`, so that secret rotation does not conflict with updates to the app. If this changes from true => false your app will be recreated unless `omit_secret` is set.",
+				ConflictsWith: []string{"client_basic_secret", "client_basic_secret_wo"},
+			},
 			"client_secret": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -443,6 +456,13 @@ other arguments that changed will be applied.`,
 				Optional:    true,
 				Description: "URL reference to JWKS",
 			},
+			"ignore_unmanaged_jwks": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "When set to true, keys in the JWKS of the app that are not listed in `jwks` are neither read into state nor removed on update. Use this when keys are added to the app with `okta_app_oauth_jwk` or by other tooling.",
+				ConflictsWith: []string{"jwks_uri"},
+			},
 			"backchannel_custom_authenticator_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -552,7 +572,7 @@ func resourceAppOAuthCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	d.SetId(oidcApp.GetId())
-	if !d.Get("omit_secret").(bool) && oidcApp.Credentials.OauthClient.HasClientSecret() {
+	if !appOAuthOmitsSecret(d) && oidcApp.Credentials.OauthClient.HasClientSecret() {
 		_ = d.Set("client_secret", oidcApp.Credentials.OauthClient.GetClientSecret())
 	}
 
@@ -913,6 +933,9 @@ func setOAuthClientSettingsV6(d *schema.ResourceData, oauthClient *v6okta.OpenId
 				}
 			}
 		}
+		if d.Get("ignore_unmanaged_jwks").(bool) {
+			arr = filterManagedAppOAuthJwks(d, arr)
+		}
 		err := utils.SetNonPrimitives(d, map[string]interface{}{"jwks": arr})
 		if err != nil {
 			return diag.Errorf("failed to set OAuth application properties: %v", err)
@@ -947,7 +970,9 @@ func resourceAppOAuthUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	// the client secret value in the api call.
 	// This is to ensure that when this is "toggled on", the apply which this occurs also does
 	// not do a final "reset" of the client secret value to the original stored in state.
-	if d.Get("omit_secret").(bool) {
+	// The same goes for skip_client_secret where the secrets are owned by
+	// okta_app_oauth_client_secret resources.
+	if appOAuthOmitsSecret(d) {
 		// Get the underlying OpenIdConnectApplication and modify the credentials
 		if oidcApp := app.OpenIdConnectApplication; oidcApp != nil {
 			credentials := oidcApp.GetCredentials()
@@ -958,6 +983,12 @@ func resourceAppOAuthUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.Get("ignore_unmanaged_jwks").(bool) {
+		if err = mergeUnmanagedAppOAuthJwks(ctx, d, meta, app); err != nil {
+			return diag.Errorf("failed to update OAuth application: %v", err)
+		}
+	}
+
 	appResp, _, err := client.ApplicationAPI.ReplaceApplication(ctx, d.Id()).Application(app).Execute()
 	if err != nil {
 		return diag.Errorf("failed to update OAuth application: %v", err)
@@ -973,7 +1004,7 @@ func resourceAppOAuthUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	// We need to make sure that we set the value in state based upon the `omit_secret` behavior
 	// When `true`: We blank out the secret value
 	// When `false`: We set the secret value to the value returned from the API
-	if d.Get("omit_secret").(bool) {
+	if appOAuthOmitsSecret(d) {
 		_ = d.Set("client_secret", "")
 	} else if updatedApp.Credentials.OauthClient.HasClientSecret() {
 		_ = d.Set("client_secret", updatedApp.Credentials.OauthClient.GetClientSecret())
@@ -1405,6 +1436,94 @@ func validateAppOAuth(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// managedAppOAuthJwkKids returns the kids of the keys listed in the jwks
+// argument, including the ones in the prior state that are being removed.
+func managedAppOAuthJwkKids(d *schema.ResourceData) map[string]bool {
+	kids := map[string]bool{}
+	oldJwks, newJwks := d.GetChange("jwks")
+	for _, jwks := range []interface{}{oldJwks, newJwks} {
+		list, _ := jwks.([]interface{})
+		for _, jwk := range list {
+			if jwkMap, ok := jwk.(map[string]interface{}); ok {
+				if kid, ok := jwkMap["kid"].(string); ok && kid != "" {
+					kids[kid] = true
+				}
+			}
+		}
+	}
+	return kids
+}
+
+// filterManagedAppOAuthJwks drops keys that are not listed in the jwks
+// argument from the flattened JWKS of the app.
+func filterManagedAppOAuthJwks(d *schema.ResourceData, arr []map[string]interface{}) []map[string]interface{} {
+	kids := managedAppOAuthJwkKids(d)
+	var filtered []map[string]interface{}
+	for _, jwk := range arr {
+		if kid, ok := jwk["kid"].(*string); ok && kid != nil && kids[*kid] {
+			filtered = append(filtered, jwk)
+		}
+	}
+	return filtered
+}
+
+// mergeUnmanagedAppOAuthJwks adds the keys of the app that are not managed by
+// the jwks argument to the app being replaced so that they survive the update.
+func mergeUnmanagedAppOAuthJwks(ctx context.Context, d *schema.ResourceData, meta interface{}, app v6okta.ListApplications200ResponseInner) error {
+	if app.OpenIdConnectApplication == nil {
+		return nil
+	}
+	currentResp, _, err := getOktaV6ClientFromMetadata(meta).ApplicationAPI.GetApplication(ctx, d.Id()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to get current JWKS of the app: %w", err)
+	}
+	current, err := verifyOidcAppTypeV6(*currentResp)
+	if err != nil {
+		return err
+	}
+	currentSettings := current.GetSettings()
+	currentClient := currentSettings.GetOauthClient()
+	currentJwks, ok := currentClient.GetJwksOk()
+	if !ok || len(currentJwks.Keys) == 0 {
+		return nil
+	}
+
+	kids := managedAppOAuthJwkKids(d)
+	settings := app.OpenIdConnectApplication.GetSettings()
+	oauthClient := settings.GetOauthClient()
+	jwks := oauthClient.GetJwks()
+	for _, key := range currentJwks.Keys {
+		if kid := appOAuthKeyKid(key); kid == "" || !kids[kid] {
+			jwks.Keys = append(jwks.Keys, key)
+		}
+	}
+	oauthClient.SetJwks(jwks)
+	settings.SetOauthClient(oauthClient)
+	app.OpenIdConnectApplication.SetSettings(settings)
+	return nil
+}
+
+func appOAuthKeyKid(key v6okta.OpenIdConnectApplicationSettingsClientKeysKeysInner) string {
+	if key.OAuth2ClientJsonEncryptionKeyResponse != nil {
+		return key.OAuth2ClientJsonEncryptionKeyResponse.GetKid()
+	}
+	if signingKey := key.OAuth2ClientJsonSigningKeyResponse; signingKey != nil {
+		if signingKey.OAuth2ClientJsonWebKeyRsaResponse != nil {
+			return signingKey.OAuth2ClientJsonWebKeyRsaResponse.GetKid()
+		}
+		if signingKey.OAuth2ClientJsonWebKeyECResponse != nil {
+			return signingKey.OAuth2ClientJsonWebKeyECResponse.GetKid()
+		}
+	}
+	return ""
+}
+
+// appOAuthOmitsSecret reports whether the client secret of the app should be
+// kept out of API calls and state.
+func appOAuthOmitsSecret(d *schema.ResourceData) bool {
+	return d.Get("omit_secret").(bool) || d.Get("skip_client_secret").(bool)
+}
+
 func verifyOidcAppTypeV6(app v6okta.ListApplications200ResponseInner) (*v6okta.OpenIdConnectApplication, error) {
 	if app.OpenIdConnectApplication != nil {
 		return app.OpenIdConnectApplication, nil
@@ -0,0 +1,250 @@
+package idaas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v6okta "github.com/okta/okta-sdk-golang/v6/okta"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+var (
+	_ resource.Resource                = &appOAuthClientSecretResource{}
+	_ resource.ResourceWithConfigure   = &appOAuthClientSecretResource{}
+	_ resource.ResourceWithImportState = &appOAuthClientSecretResource{}
+)
+
+type appOAuthClientSecretResource struct {
+	*config.Config
+}
+
+type appOAuthClientSecretModel struct {
+	ID           types.String `tfsdk:"id"`
+	AppID        types.String `tfsdk:"app_id"`
+	Status       types.String `tfsdk:"status"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	SecretHash   types.String `tfsdk:"secret_hash"`
+	Created      types.String `tfsdk:"created"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+}
+
+func newAppOAuthClientSecretResource() resource.Resource {
+	return &appOAuthClientSecretResource{}
+}
+
+func (r *appOAuthClientSecretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_oauth_client_secret"
+}
+
+func (r *appOAuthClientSecretResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *appOAuthClientSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected format: app_id/secret_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func (r *appOAuthClientSecretResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single client secret of an OAuth application. An app can hold up to two secrets at a time, which allows rotating secrets without downtime when used together with `create_before_destroy`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the client secret.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the OAuth application.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(StatusActive),
+				Description: "Status of the client secret. Valid values: `ACTIVE`, `INACTIVE`. Default: `ACTIVE`. A secret must be `INACTIVE` before it can be deleted, the provider deactivates it automatically on destroy.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(StatusActive, StatusInactive),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The client secret value. It is captured once when the secret is created and is not refreshed afterwards, imported secrets will not have this value set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the client secret, can be used to identify the secret without exposing it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the client secret was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the client secret was last updated.",
+			},
+		},
+	}
+}
+
+func (r *appOAuthClientSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data appOAuthClientSecretModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := v6okta.NewOAuth2ClientSecretRequestBody()
+	body.SetStatus(data.Status.ValueString())
+	secret, _, err := r.OktaIDaaSClient.OktaSDKClientV6().ApplicationSSOPublicKeysAPI.CreateOAuth2ClientSecret(ctx, data.AppID.ValueString()).OAuth2ClientSecretRequestBody(*body).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating OAuth app client secret",
+			"Could not create OAuth app client secret, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ClientSecret = types.StringValue(secret.GetClientSecret())
+	applyAppOAuthClientSecretToState(&data, secret)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appOAuthClientSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data appOAuthClientSecretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, apiResp, err := r.OktaIDaaSClient.OktaSDKClientV6().ApplicationSSOPublicKeysAPI.GetOAuth2ClientSecret(ctx, data.AppID.ValueString(), data.ID.ValueString()).Execute()
+	if err != nil {
+		if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading OAuth app client secret",
+			"Could not read OAuth app client secret, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// client_secret is left as-is, it is only captured on create
+	applyAppOAuthClientSecretToState(&data, secret)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appOAuthClientSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, data appOAuthClientSecretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.setAppOAuthClientSecretStatus(ctx, state.AppID.ValueString(), state.ID.ValueString(), data.Status.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating OAuth app client secret",
+			"Could not update OAuth app client secret status, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = state.ID
+	data.ClientSecret = state.ClientSecret
+	applyAppOAuthClientSecretToState(&data, secret)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appOAuthClientSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data appOAuthClientSecretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID, secretID := data.AppID.ValueString(), data.ID.ValueString()
+	// Okta only allows deleting inactive secrets
+	if data.Status.ValueString() != StatusInactive {
+		_, apiResp, err := r.OktaIDaaSClient.OktaSDKClientV6().ApplicationSSOPublicKeysAPI.DeactivateOAuth2ClientSecret(ctx, appID, secretID).Execute()
+		if err != nil {
+			if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error deleting OAuth app client secret",
+				"Could not deactivate OAuth app client secret before deletion, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	apiResp, err := r.OktaIDaaSClient.OktaSDKClientV6().ApplicationSSOPublicKeysAPI.DeleteOAuth2ClientSecret(ctx, appID, secretID).Execute()
+	if err != nil {
+		if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting OAuth app client secret",
+			"Could not delete OAuth app client secret, unexpected error: "+err.Error(),
+		)
+	}
+}
+
+func (r *appOAuthClientSecretResource) setAppOAuthClientSecretStatus(ctx context.Context, appID, secretID, status string) (*v6okta.OAuth2ClientSecret, error) {
+	api := r.OktaIDaaSClient.OktaSDKClientV6().ApplicationSSOPublicKeysAPI
+	switch status {
+	case StatusActive:
+		secret, _, err := api.ActivateOAuth2ClientSecret(ctx, appID, secretID).Execute()
+		return secret, err
+	case StatusInactive:
+		secret, _, err := api.DeactivateOAuth2ClientSecret(ctx, appID, secretID).Execute()
+		return secret, err
+	}
+	return nil, fmt.Errorf("unsupported client secret status %q", status)
+}
+
+func applyAppOAuthClientSecretToState(data *appOAuthClientSecretModel, secret *v6okta.OAuth2ClientSecret) {
+	data.ID = types.StringValue(secret.GetId())
+	data.Status = types.StringValue(secret.GetStatus())
+	data.SecretHash = types.StringValue(secret.GetSecretHash())
+	data.Created = types.StringValue(secret.GetCreated())
+	data.LastUpdated = types.StringValue(secret.GetLastUpdated())
+}
@@ -0,0 +1,500 @@
+package idaas
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v6okta "github.com/okta/okta-sdk-golang/v6/okta"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+)
+
+var (
+	_ resource.Resource                = &appOAuthJwkResource{}
+	_ resource.ResourceWithConfigure   = &appOAuthJwkResource{}
+	_ resource.ResourceWithImportState = &appOAuthJwkResource{}
+)
+
+type appOAuthJwkResource struct {
+	*config.Config
+}
+
+type appOAuthJwkModel struct {
+	ID           types.String `tfsdk:"id"`
+	AppID        types.String `tfsdk:"app_id"`
+	PublicKeyPEM types.String `tfsdk:"public_key_pem"`
+	JwkJSON      types.String `tfsdk:"jwk_json"`
+	Kid          types.String `tfsdk:"kid"`
+	Use          types.String `tfsdk:"use"`
+	Status       types.String `tfsdk:"status"`
+	Kty          types.String `tfsdk:"kty"`
+	E            types.String `tfsdk:"e"`
+	N            types.String `tfsdk:"n"`
+	X            types.String `tfsdk:"x"`
+	Y            types.String `tfsdk:"y"`
+	Created      types.String `tfsdk:"created"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+}
+
+func newAppOAuthJwkResource() resource.Resource {
+	return &appOAuthJwkResource{}
+}
+
+func (r *appOAuthJwkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_oauth_jwk"
+}
+
+func (r *appOAuthJwkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *appOAuthJwkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected format: app_id/key_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func (r *appOAuthJwkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single public key in the JWKS of an OAuth application. Use this resource instead of the inline `jwks` argument of `okta_app_oauth` to add or rotate keys without rewriting the whole application. Set `ignore_unmanaged_jwks` on the `okta_app_oauth` resource so that it leaves these keys alone.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Okta ID of the JSON Web Key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the OAuth application.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_key_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded RSA or EC public key, or a certificate containing one. Exactly one of `public_key_pem` or `jwk_json` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("jwk_json"),
+					}...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"jwk_json": schema.StringAttribute{
+				Optional:    true,
+				Description: "The public key as a JSON Web Key object. Private key members are rejected. Exactly one of `public_key_pem` or `jwk_json` must be set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kid": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Unique identifier of the key in the JWKS of the app. When omitted the `kid` of `jwk_json` is used, otherwise it is derived from the RFC 7638 SHA-256 thumbprint of the key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"use": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("sig"),
+				Description: "Acceptable use of the key. Valid values: `sig`, `enc`. Default: `sig`. Encryption keys must be RSA keys.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("sig", "enc"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(StatusActive),
+				Description: "Status of the key. Valid values: `ACTIVE`, `INACTIVE`. Default: `ACTIVE`. Active keys are deactivated before they are deleted.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(StatusActive, StatusInactive),
+				},
+			},
+			"kty": schema.StringAttribute{
+				Computed:    true,
+				Description: "Key type, `RSA` or `EC`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"e": schema.StringAttribute{
+				Computed:    true,
+				Description: "RSA exponent.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"n": schema.StringAttribute{
+				Computed:    true,
+				Description: "RSA modulus.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"x": schema.StringAttribute{
+				Computed:    true,
+				Description: "X coordinate of the elliptic curve point.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"y": schema.StringAttribute{
+				Computed:    true,
+				Description: "Y coordinate of the elliptic curve point.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the key was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the key was last updated.",
+			},
+		},
+	}
+}
+
+func (r *appOAuthJwkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data appOAuthJwkModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := BuildAppOAuthJwk(data.PublicKeyPEM.ValueString(), data.JwkJSON.ValueString(), data.Kid.ValueString(), data.Use.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid OAuth app JSON Web Key", err.Error())
+		return
+	}
+
+	_, apiResp, err := r.OktaIDaaSClient.OktaSDKClientV6().ApplicationSSOPublicKeysAPI.AddJwk(ctx, data.AppID.ValueString()).AddJwkRequest(key.addJwkRequest(data.Status.ValueString())).Execute()
+	created, err := appOAuthJwkFromResponse(apiResp, err)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating OAuth app JSON Web Key",
+			"Could not add JSON Web Key to OAuth app, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	applyAppOAuthJwkToState(&data, created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appOAuthJwkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data appOAuthJwkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, apiResp, err := r.OktaIDaaSClient.OktaSDKClientV6().ApplicationSSOPublicKeysAPI.GetJwk(ctx, data.AppID.ValueString(), data.ID.ValueString()).Execute()
+	if err != nil {
+		if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading OAuth app JSON Web Key",
+			"Could not read OAuth app JSON Web Key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	applyAppOAuthJwkToState(&data, key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appOAuthJwkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, data appOAuthJwkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// status is the only argument that can change without replacement
+	api := r.OktaIDaaSClient.OktaSDKClientV6().ApplicationSSOPublicKeysAPI
+	var apiResp *v6okta.APIResponse
+	var err error
+	if data.Status.ValueString() == StatusActive {
+		_, apiResp, err = api.ActivateOAuth2ClientJsonWebKey(ctx, state.AppID.ValueString(), state.ID.ValueString()).Execute()
+	} else {
+		_, apiResp, err = api.DeactivateOAuth2ClientJsonWebKey(ctx, state.AppID.ValueString(), state.ID.ValueString()).Execute()
+	}
+	key, err := appOAuthJwkFromResponse(apiResp, err)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating OAuth app JSON Web Key",
+			"Could not update OAuth app JSON Web Key status, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = state.ID
+	applyAppOAuthJwkToState(&data, key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appOAuthJwkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data appOAuthJwkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api := r.OktaIDaaSClient.OktaSDKClientV6().ApplicationSSOPublicKeysAPI
+	appID, keyID := data.AppID.ValueString(), data.ID.ValueString()
+	// Okta only allows deleting inactive keys
+	if data.Status.ValueString() != StatusInactive {
+		_, apiResp, err := api.DeactivateOAuth2ClientJsonWebKey(ctx, appID, keyID).Execute()
+		if err != nil {
+			if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error deleting OAuth app JSON Web Key",
+				"Could not deactivate OAuth app JSON Web Key before deletion, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	apiResp, err := api.Deletejwk(ctx, appID, keyID).Execute()
+	if err := utils.SuppressErrorOn404_V6(apiResp, err); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting OAuth app JSON Web Key",
+			"Could not delete OAuth app JSON Web Key, unexpected error: "+err.Error(),
+		)
+	}
+}
+
+// appOAuthJwkFromResponse decodes the key from the body of a JWK lifecycle
+// response. The v6 response union of the add and activate calls matches RSA
+// and EC keys as both a signing and an encryption key and fails to decode
+// them, although the call succeeded.
+func appOAuthJwkFromResponse(apiResp *v6okta.APIResponse, err error) (*v6okta.OAuth2ClientJsonSigningKeyResponse, error) {
+	if apiResp == nil || apiResp.Response == nil || apiResp.StatusCode >= http.StatusMultipleChoices {
+		return nil, err
+	}
+	var key v6okta.OAuth2ClientJsonSigningKeyResponse
+	if err := json.NewDecoder(apiResp.Body).Decode(&key); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON Web Key: %w", err)
+	}
+	return &key, nil
+}
+
+func applyAppOAuthJwkToState(data *appOAuthJwkModel, key *v6okta.OAuth2ClientJsonSigningKeyResponse) {
+	var use string
+	if rsaKey := key.OAuth2ClientJsonWebKeyRsaResponse; rsaKey != nil {
+		data.ID = types.StringValue(rsaKey.GetId())
+		data.Kid = types.StringValue(rsaKey.GetKid())
+		data.Kty = types.StringValue(rsaKey.GetKty())
+		data.Status = types.StringValue(rsaKey.GetStatus())
+		data.E = types.StringValue(rsaKey.GetE())
+		data.N = types.StringValue(rsaKey.GetN())
+		data.X = types.StringValue("")
+		data.Y = types.StringValue("")
+		data.Created = types.StringValue(rsaKey.GetCreated())
+		data.LastUpdated = types.StringValue(rsaKey.GetLastUpdated())
+		use, _ = rsaKey.AdditionalProperties["use"].(string)
+	} else if ecKey := key.OAuth2ClientJsonWebKeyECResponse; ecKey != nil {
+		data.ID = types.StringValue(ecKey.GetId())
+		data.Kid = types.StringValue(ecKey.GetKid())
+		data.Kty = types.StringValue(ecKey.GetKty())
+		data.Status = types.StringValue(ecKey.GetStatus())
+		data.E = types.StringValue("")
+		data.N = types.StringValue("")
+		data.X = types.StringValue(ecKey.GetX())
+		data.Y = types.StringValue(ecKey.GetY())
+		data.Created = types.StringValue(ecKey.GetCreated())
+		data.LastUpdated = types.StringValue(ecKey.GetLastUpdated())
+		use, _ = ecKey.AdditionalProperties["use"].(string)
+	}
+	if use != "" {
+		data.Use = types.StringValue(use)
+	} else if data.Use.IsNull() || data.Use.IsUnknown() {
+		data.Use = types.StringValue("sig")
+	}
+}
+
+// AppOAuthJwk is the public key material of an OAuth app JSON Web Key.
+type AppOAuthJwk struct {
+	Kid string
+	Kty string
+	Use string
+	E   string
+	N   string
+	Crv string
+	X   string
+	Y   string
+}
+
+// addJwkRequest returns the v6 request adding the key with the given status.
+// The v6 signing key requests do not model the curve of EC keys, it is sent as
+// an additional property.
+func (k *AppOAuthJwk) addJwkRequest(status string) v6okta.AddJwkRequest {
+	kid := v6okta.NewNullableString(&k.Kid)
+	switch {
+	case k.Use == "enc":
+		return v6okta.OAuth2ClientJsonEncryptionKeyRequestAsAddJwkRequest(&v6okta.OAuth2ClientJsonEncryptionKeyRequest{
+			Kty:    &k.Kty,
+			E:      &k.E,
+			N:      &k.N,
+			Use:    &k.Use,
+			Kid:    *kid,
+			Status: &status,
+		})
+	case k.Kty == "EC":
+		signingKey := v6okta.OAuth2ClientJsonWebKeyECRequestAsOAuth2ClientJsonSigningKeyRequest(&v6okta.OAuth2ClientJsonWebKeyECRequest{
+			Kty:                  &k.Kty,
+			X:                    &k.X,
+			Y:                    &k.Y,
+			Kid:                  *kid,
+			Status:               &status,
+			AdditionalProperties: map[string]interface{}{"crv": k.Crv},
+		})
+		return v6okta.OAuth2ClientJsonSigningKeyRequestAsAddJwkRequest(&signingKey)
+	default:
+		signingKey := v6okta.OAuth2ClientJsonWebKeyRsaRequestAsOAuth2ClientJsonSigningKeyRequest(&v6okta.OAuth2ClientJsonWebKeyRsaRequest{
+			Kty:    &k.Kty,
+			E:      &k.E,
+			N:      &k.N,
+			Kid:    *kid,
+			Status: &status,
+		})
+		return v6okta.OAuth2ClientJsonSigningKeyRequestAsAddJwkRequest(&signingKey)
+	}
+}
+
+// BuildAppOAuthJwk converts either a PEM encoded public key (or certificate)
+// or a JWK JSON object into the public JWK that is added to an OAuth app. When
+// kid is empty the kid of the JWK JSON is used, falling back to the RFC 7638
+// SHA-256 thumbprint of the key.
+func BuildAppOAuthJwk(publicKeyPEM, jwkJSON, kid, use string) (*AppOAuthJwk, error) {
+	var jwk jose.JSONWebKey
+	switch {
+	case publicKeyPEM != "":
+		pub, err := parsePublicKeyPEM(publicKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+		jwk = jose.JSONWebKey{Key: pub}
+	case jwkJSON != "":
+		if err := json.Unmarshal([]byte(jwkJSON), &jwk); err != nil {
+			return nil, fmt.Errorf("failed to parse JWK JSON: %w", err)
+		}
+		if !jwk.IsPublic() {
+			return nil, errors.New("JWK JSON contains private key material, only public keys can be added to an app")
+		}
+	default:
+		return nil, errors.New("either a PEM encoded public key or a JWK JSON object is required")
+	}
+
+	if kid == "" {
+		kid = jwk.KeyID
+	}
+	if kid == "" {
+		thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute JWK thumbprint: %w", err)
+		}
+		kid = base64.RawURLEncoding.EncodeToString(thumbprint)
+	}
+
+	key := &AppOAuthJwk{Kid: kid}
+	switch pub := jwk.Key.(type) {
+	case *rsa.PublicKey:
+		key.Kty = "RSA"
+		key.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		key.E = base64.RawURLEncoding.EncodeToString(bigEndianInt(pub.E))
+	case *ecdsa.PublicKey:
+		if use == "enc" {
+			return nil, errors.New("only RSA keys can be used for encryption")
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		key.Kty = "EC"
+		key.Crv = pub.Curve.Params().Name
+		key.X = base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, size)))
+		key.Y = base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, size)))
+	default:
+		return nil, fmt.Errorf("unsupported key type %T, only RSA and EC keys are supported", jwk.Key)
+	}
+	if use == "enc" {
+		key.Use = use
+	}
+	return key, nil
+}
+
+func parsePublicKeyPEM(data string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(data)))
+	if block == nil {
+		return nil, errors.New("failed to decode PEM block containing the public key")
+	}
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate: %w", err)
+		}
+		return cert.PublicKey, nil
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	return nil, fmt.Errorf("unsupported PEM block type %q, expected a public key or certificate", block.Type)
+}
+
+func bigEndianInt(i int) []byte {
+	b := make([]byte, 0, 8)
+	for ; i > 0; i >>= 8 {
+		b = append([]byte{byte(i & 0xff)}, b...)
+	}
+	return b
+}
@@ -0,0 +1,167 @@
+package idaas_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+	"github.com/okta/terraform-provider-okta/okta/services/idaas"
+)
+
+// RFC 7638 section 3.1 example key
+const rfc7638JWK = `{
+  "kty": "RSA",
+  "n": "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+  "e": "AQAB",
+  "alg": "RS256"
+}`
+
+func TestBuildAppOAuthJwk(t *testing.T) {
+	t.Run("thumbprint kid from JWK JSON", func(t *testing.T) {
+		key, err := idaas.BuildAppOAuthJwk("", rfc7638JWK, "", "sig")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if key.Kid != "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs" {
+			t.Errorf("unexpected kid %q", key.Kid)
+		}
+		if key.Kty != "RSA" || key.E != "AQAB" || key.Use != "" {
+			t.Errorf("unexpected key %+v", key)
+		}
+	})
+
+	t.Run("explicit kid wins", func(t *testing.T) {
+		key, err := idaas.BuildAppOAuthJwk("", rfc7638JWK, "my-kid", "enc")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if key.Kid != "my-kid" || key.Use != "enc" {
+			t.Errorf("unexpected key %+v", key)
+		}
+	})
+
+	t.Run("RSA PEM matches JWK JSON", func(t *testing.T) {
+		priv, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		der, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkixPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+		pkcs1 := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&priv.PublicKey)}))
+
+		fromPKIX, err := idaas.BuildAppOAuthJwk(pkixPEM, "", "", "sig")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		fromPKCS1, err := idaas.BuildAppOAuthJwk(pkcs1, "", "", "sig")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if *fromPKIX != *fromPKCS1 {
+			t.Errorf("expected identical keys, got %+v and %+v", fromPKIX, fromPKCS1)
+		}
+		fromJSON, err := idaas.BuildAppOAuthJwk("", fmt.Sprintf(`{"kty":"RSA","n":%q,"e":%q}`, fromPKIX.N, fromPKIX.E), "", "sig")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if fromJSON.Kid != fromPKIX.Kid {
+			t.Errorf("expected kid %q, got %q", fromPKIX.Kid, fromJSON.Kid)
+		}
+	})
+
+	t.Run("EC certificate", func(t *testing.T) {
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "test"},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+		key, err := idaas.BuildAppOAuthJwk(cert, "", "", "sig")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if key.Kty != "EC" || key.Crv != "P-256" || len(key.X) != 43 || len(key.Y) != 43 || key.Kid == "" {
+			t.Errorf("unexpected key %+v", key)
+		}
+
+		if _, err = idaas.BuildAppOAuthJwk(cert, "", "", "enc"); err == nil {
+			t.Error("expected error for EC encryption key")
+		}
+	})
+
+	t.Run("private JWK is rejected", func(t *testing.T) {
+		private := `{"kty":"EC","crv":"P-256","x":"MKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4","y":"4Etl6SRW2YiLUrN5vfvVHuhp7x8PxltmWWlbbM4IFyM","d":"870MB6gfuTJ4HtUnUvYMyJpr5eUZNP4Bk43bVdj3eAE"}`
+		if _, err := idaas.BuildAppOAuthJwk("", private, "", "sig"); err == nil {
+			t.Error("expected error for private JWK")
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		if _, err := idaas.BuildAppOAuthJwk("not a pem", "", "", "sig"); err == nil {
+			t.Error("expected error for invalid PEM")
+		}
+		if _, err := idaas.BuildAppOAuthJwk("", "", "", "sig"); err == nil {
+			t.Error("expected error for missing key")
+		}
+	})
+}
+
+func TestAccResourceOktaAppOAuthJwk_crud(t *testing.T) {
+	mgr := newFixtureManager("resources", resources.OktaIDaaSAppOAuthJwk, t.Name())
+	config := mgr.GetFixtures("basic.tf", t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", t)
+	resourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSAppOAuthJwk)
+	appResourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSAppOAuth)
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		CheckDestroy:             checkResourceDestroy(resources.OktaIDaaSAppOAuth, createDoesOAuthAppExist()),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "app_id", appResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "kid", "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs"),
+					resource.TestCheckResourceAttr(resourceName, "kty", "RSA"),
+					resource.TestCheckResourceAttr(resourceName, "e", "AQAB"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(appResourceName, "jwks.#", "1"),
+					resource.TestCheckResourceAttr(appResourceName, "jwks.0.kid", "SIGNING_KEY_EC"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "INACTIVE"),
+					resource.TestCheckResourceAttr(appResourceName, "jwks.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -89,9 +89,11 @@ request feature flag 'ADVANCED_SSO' be applied to your org.`,
 				RequiredWith: []string{"key_years_valid"},
 			},
 			"key_id": {
-				Type:        schema.TypeString,
-				Description: "Certificate ID",
-				Computed:    true,
+				Type:          schema.TypeString,
+				Description:   "Certificate ID. Set this to the `key_id` of an `okta_app_saml_signing_certificate` to sign with a certificate issued by an external CA.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"key_name"},
 			},
 			"key_years_valid": {
 				Type:        schema.TypeInt,
@@ -0,0 +1,314 @@
+package idaas
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v5okta "github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+var (
+	_ resource.Resource                = &appSamlSigningCertificateResource{}
+	_ resource.ResourceWithConfigure   = &appSamlSigningCertificateResource{}
+	_ resource.ResourceWithImportState = &appSamlSigningCertificateResource{}
+)
+
+type appSamlSigningCertificateResource struct {
+	*config.Config
+}
+
+type appSamlSigningCertificateModel struct {
+	ID             types.String `tfsdk:"id"`
+	AppID          types.String `tfsdk:"app_id"`
+	CsrID          types.String `tfsdk:"csr_id"`
+	CertificatePEM types.String `tfsdk:"certificate_pem"`
+	KeyID          types.String `tfsdk:"key_id"`
+	Kty            types.String `tfsdk:"kty"`
+	X5tS256        types.String `tfsdk:"x5t_s256"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	Created        types.String `tfsdk:"created"`
+}
+
+func newAppSamlSigningCertificateResource() resource.Resource {
+	return &appSamlSigningCertificateResource{}
+}
+
+func (r *appSamlSigningCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_saml_signing_certificate"
+}
+
+func (r *appSamlSigningCertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *appSamlSigningCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected format: app_id/key_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// requiresReplaceUnlessImported forces a new resource when an argument
+// changes, except when it is unset in state because the resource was imported.
+func requiresReplaceUnlessImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"Changing this value forces a new resource unless the resource was imported.",
+		"Changing this value forces a new resource unless the resource was imported.",
+	)
+}
+
+func (r *appSamlSigningCertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Publishes a CA signed certificate for a CSR generated with `okta_app_saml_signing_csr`, which turns the CSR into a signing key of the SAML application. Okta does not allow deleting app keys, destroying this resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The key ID (kid) of the published key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the SAML application.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"csr_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the CSR the certificate was issued for.",
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImported(),
+				},
+			},
+			"certificate_pem": schema.StringAttribute{
+				Required:    true,
+				Description: "The PEM encoded certificate issued by the CA for the CSR. The certificate is checked against the public key of the CSR before it is published.",
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImported(),
+				},
+			},
+			"key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The key ID (kid) of the published key. Set the `key_id` argument of `okta_app_saml` to it to make the key the signing key of the app.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"kty": schema.StringAttribute{
+				Computed:    true,
+				Description: "Key type of the published key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"x5t_s256": schema.StringAttribute{
+				Computed:    true,
+				Description: "Base64url encoded SHA-256 thumbprint of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the certificate expires.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the key was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *appSamlSigningCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data appSamlSigningCertificateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID, csrID := data.AppID.ValueString(), data.CsrID.ValueString()
+	csr, _, err := r.OktaIDaaSClient.OktaSDKClientV5().ApplicationCredentialsAPI.GetCsrForApplication(ctx, appID, csrID).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating SAML app signing certificate",
+			fmt.Sprintf("Could not read CSR %s of app %s, unexpected error: %s", csrID, appID, err.Error()),
+		)
+		return
+	}
+	if err = ValidateSamlSigningCertificate(csr.GetCsr(), data.CertificatePEM.ValueString(), time.Now()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("certificate_pem"),
+			"Invalid SAML app signing certificate",
+			err.Error(),
+		)
+		return
+	}
+
+	key, err := r.publishAppCsrCertificate(ctx, appID, csrID, data.CertificatePEM.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating SAML app signing certificate",
+			"Could not publish SAML app signing certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	applyAppSamlSigningCertificateToState(&data, key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appSamlSigningCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data appSamlSigningCertificateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, apiResp, err := r.OktaIDaaSClient.OktaSDKClientV5().ApplicationCredentialsAPI.GetApplicationKey(ctx, data.AppID.ValueString(), data.ID.ValueString()).Execute()
+	if err != nil {
+		if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading SAML app signing certificate",
+			"Could not read SAML app signing certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	applyAppSamlSigningCertificateToState(&data, key)
+	if data.CertificatePEM.IsNull() && len(key.X5c) > 0 {
+		if der, err := base64.StdEncoding.DecodeString(key.X5c[0]); err == nil {
+			data.CertificatePEM = types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appSamlSigningCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, data appSamlSigningCertificateModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only csr_id and certificate_pem of an imported key are updated in
+	// place, they are not sent to Okta
+	data.ID = state.ID
+	data.KeyID = state.KeyID
+	data.Kty = state.Kty
+	data.X5tS256 = state.X5tS256
+	data.ExpiresAt = state.ExpiresAt
+	data.Created = state.Created
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appSamlSigningCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning(
+		"Delete Not Supported",
+		"Okta does not support deleting app keys. The key stays on the SAML app until it expires, it has only been removed from the Terraform state.",
+	)
+}
+
+// publishAppCsrCertificate publishes the certificate issued for a CSR of the
+// app. The v5 SDK reads the certificate from a file and sends it as
+// application/x-x509-ca-cert, which takes base64 encoded DER.
+func (r *appSamlSigningCertificateResource) publishAppCsrCertificate(ctx context.Context, appID, csrID, certificatePEM string) (*v5okta.JsonWebKey, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(certificatePEM)))
+	if block == nil {
+		return nil, errors.New("certificate_pem does not contain a PEM encoded certificate")
+	}
+	file, err := os.CreateTemp("", "okta-app-csr-*.cer")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+	if _, err = file.WriteString(base64.StdEncoding.EncodeToString(block.Bytes)); err != nil {
+		return nil, err
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	key, _, err := r.OktaIDaaSClient.OktaSDKClientV5().ApplicationCredentialsAPI.PublishCsrFromApplication(ctx, appID, csrID).Body(file).Execute()
+	return key, err
+}
+
+func applyAppSamlSigningCertificateToState(data *appSamlSigningCertificateModel, key *v5okta.JsonWebKey) {
+	data.ID = types.StringValue(key.GetKid())
+	data.KeyID = types.StringValue(key.GetKid())
+	data.Kty = types.StringValue(key.GetKty())
+	data.X5tS256 = types.StringValue(key.GetX5tS256())
+	data.ExpiresAt = types.StringNull()
+	if key.ExpiresAt != nil {
+		data.ExpiresAt = types.StringValue(key.ExpiresAt.Format(time.RFC3339))
+	}
+	data.Created = types.StringNull()
+	if key.Created != nil {
+		data.Created = types.StringValue(key.Created.Format(time.RFC3339))
+	}
+}
+
+// ValidateSamlSigningCertificate checks that the PEM encoded certificate was
+// issued for the public key of the CSR and that it is valid at the given time,
+// so that mistakes are caught before the certificate is published to Okta.
+func ValidateSamlSigningCertificate(csr, certificatePEM string, now time.Time) error {
+	req, err := parseAppCsr(csr)
+	if err != nil {
+		return err
+	}
+	block, _ := pem.Decode([]byte(strings.TrimSpace(certificatePEM)))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return errors.New("certificate_pem does not contain a PEM encoded certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse certificate: %w", err)
+	}
+	if !publicKeysEqual(req.PublicKey, cert.PublicKey) {
+		return errors.New("the public key of the certificate does not match the public key of the CSR")
+	}
+	if now.Before(cert.NotBefore) {
+		return fmt.Errorf("the certificate is not valid before %s", cert.NotBefore.Format(time.RFC3339))
+	}
+	if now.After(cert.NotAfter) {
+		return fmt.Errorf("the certificate expired at %s", cert.NotAfter.Format(time.RFC3339))
+	}
+	return nil
+}
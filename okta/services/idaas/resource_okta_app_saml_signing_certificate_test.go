@@ -0,0 +1,152 @@
+package idaas_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	v5okta "github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/okta/terraform-provider-okta/okta/services/idaas"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+)
+
+type localCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newLocalCA(t *testing.T) *localCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Local Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &localCA{cert: cert, key: key}
+}
+
+// sign issues a certificate for the CSR in the PEM format an operator would
+// hand to okta_app_saml_signing_certificate, and returns its DER as well.
+func (ca *localCA) sign(t *testing.T, csrPEM string, notBefore, notAfter time.Time) (string, []byte) {
+	t.Helper()
+	block, _ := pem.Decode([]byte(csrPEM))
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = csr.CheckSignature(); err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      csr.Subject,
+		DNSNames:     csr.DNSNames,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, csr.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), der
+}
+
+func TestAppSamlSigningCertificateFlow(t *testing.T) {
+	ca := newLocalCA(t)
+	now := time.Now()
+
+	// okta_app_saml_signing_csr
+	_, csr := generateOktaCsr(t)
+	csrPEM, err := idaas.AppCsrPEM(csr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the CA signs the CSR
+	certPEM, certDER := ca.sign(t, csrPEM, now.Add(-time.Minute), now.Add(time.Hour))
+
+	// okta_app_saml_signing_certificate validates before publishing
+	if err = idaas.ValidateSamlSigningCertificate(csr, certPEM, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// once published, Okta exposes the certificate as an app key and drops
+	// the CSR, the CSR resource finds its key through the certificate
+	keys := []v5okta.JsonWebKey{{
+		Kid: utils.StringPtr("published"),
+		Kty: utils.StringPtr("RSA"),
+		X5c: []string{base64.StdEncoding.EncodeToString(certDER)},
+	}}
+	key, err := idaas.FindAppKeyForCsr(csr, keys)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key == nil || key.GetKid() != "published" {
+		t.Errorf("expected published key, got %+v", key)
+	}
+}
+
+func TestValidateSamlSigningCertificate(t *testing.T) {
+	ca := newLocalCA(t)
+	now := time.Now()
+	_, csr := generateOktaCsr(t)
+	csrPEM, err := idaas.AppCsrPEM(csr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, otherCsr := generateOktaCsr(t)
+	otherCsrPEM, err := idaas.AppCsrPEM(otherCsr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validPEM, _ := ca.sign(t, csrPEM, now.Add(-time.Minute), now.Add(time.Hour))
+	expiredPEM, _ := ca.sign(t, csrPEM, now.Add(-2*time.Hour), now.Add(-time.Hour))
+	futurePEM, _ := ca.sign(t, csrPEM, now.Add(time.Hour), now.Add(2*time.Hour))
+	otherPEM, _ := ca.sign(t, otherCsrPEM, now.Add(-time.Minute), now.Add(time.Hour))
+
+	tests := []struct {
+		name    string
+		cert    string
+		wantErr bool
+	}{
+		{"valid", validPEM, false},
+		{"expired", expiredPEM, true},
+		{"not yet valid", futurePEM, true},
+		{"issued for another CSR", otherPEM, true},
+		{"CSR instead of certificate", csrPEM, true},
+		{"garbage", "not a certificate", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := idaas.ValidateSamlSigningCertificate(csr, tt.cert, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSamlSigningCertificate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
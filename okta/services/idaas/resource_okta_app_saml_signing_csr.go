@@ -0,0 +1,407 @@
+package idaas
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v5okta "github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+)
+
+var (
+	_ resource.Resource                = &appSamlSigningCsrResource{}
+	_ resource.ResourceWithConfigure   = &appSamlSigningCsrResource{}
+	_ resource.ResourceWithImportState = &appSamlSigningCsrResource{}
+)
+
+type appSamlSigningCsrResource struct {
+	*config.Config
+}
+
+type appSamlSigningCsrSubjectModel struct {
+	CommonName             types.String `tfsdk:"common_name"`
+	CountryName            types.String `tfsdk:"country_name"`
+	LocalityName           types.String `tfsdk:"locality_name"`
+	OrganizationName       types.String `tfsdk:"organization_name"`
+	OrganizationalUnitName types.String `tfsdk:"organizational_unit_name"`
+	StateOrProvinceName    types.String `tfsdk:"state_or_province_name"`
+}
+
+type appSamlSigningCsrModel struct {
+	ID       types.String                   `tfsdk:"id"`
+	AppID    types.String                   `tfsdk:"app_id"`
+	Subject  *appSamlSigningCsrSubjectModel `tfsdk:"subject"`
+	DNSNames types.List                     `tfsdk:"dns_names"`
+	Csr      types.String                   `tfsdk:"csr"`
+	CsrPEM   types.String                   `tfsdk:"csr_pem"`
+	Kty      types.String                   `tfsdk:"kty"`
+	Created  types.String                   `tfsdk:"created"`
+}
+
+func newAppSamlSigningCsrResource() resource.Resource {
+	return &appSamlSigningCsrResource{}
+}
+
+func (r *appSamlSigningCsrResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_saml_signing_csr"
+}
+
+func (r *appSamlSigningCsrResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *appSamlSigningCsrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected format: app_id/csr_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func (r *appSamlSigningCsrResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	subjectAttribute := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:    true,
+			Description: description,
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: "Generates a Certificate Signing Request (CSR) for the signing key of a SAML application. Okta generates the key pair and keeps the private key, the CSR is signed by an external CA and the resulting certificate is published with `okta_app_saml_signing_certificate`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the CSR.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the SAML application.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dns_names": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "DNS names added as subject alternative names of the CSR.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"csr": schema.StringAttribute{
+				Computed:    true,
+				Description: "The base64 encoded DER CSR as returned by Okta.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"csr_pem": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM encoded CSR, suitable as input for a CA.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"kty": schema.StringAttribute{
+				Computed:    true,
+				Description: "Key type of the generated key pair.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp when the CSR was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"subject": schema.SingleNestedBlock{
+				Description: "Subject of the CSR.",
+				Attributes: map[string]schema.Attribute{
+					"common_name":              subjectAttribute("Common name (CN) of the subject."),
+					"country_name":             subjectAttribute("Two letter country code (C) of the subject."),
+					"locality_name":            subjectAttribute("Locality (L) of the subject."),
+					"organization_name":        subjectAttribute("Organization (O) of the subject."),
+					"organizational_unit_name": subjectAttribute("Organizational unit (OU) of the subject."),
+					"state_or_province_name":   subjectAttribute("State or province (ST) of the subject."),
+				},
+				Validators: []validator.Object{
+					objectvalidator.IsRequired(),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *appSamlSigningCsrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data appSamlSigningCsrModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metadata := v5okta.CsrMetadata{
+		Subject: &v5okta.CsrMetadataSubject{
+			CommonName:             data.Subject.CommonName.ValueStringPointer(),
+			CountryName:            data.Subject.CountryName.ValueStringPointer(),
+			LocalityName:           data.Subject.LocalityName.ValueStringPointer(),
+			OrganizationName:       data.Subject.OrganizationName.ValueStringPointer(),
+			OrganizationalUnitName: data.Subject.OrganizationalUnitName.ValueStringPointer(),
+			StateOrProvinceName:    data.Subject.StateOrProvinceName.ValueStringPointer(),
+		},
+	}
+	if !data.DNSNames.IsNull() && !data.DNSNames.IsUnknown() {
+		var dnsNames []string
+		resp.Diagnostics.Append(data.DNSNames.ElementsAs(ctx, &dnsNames, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		metadata.SubjectAltNames = &v5okta.CsrMetadataSubjectAltNames{DnsNames: dnsNames}
+	}
+
+	csr, _, err := r.OktaIDaaSClient.OktaSDKClientV5().ApplicationCredentialsAPI.GenerateCsrForApplication(ctx, data.AppID.ValueString()).Metadata(metadata).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating SAML app signing CSR",
+			"Could not create SAML app signing CSR, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(applyAppSamlSigningCsrToState(&data, csr)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appSamlSigningCsrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data appSamlSigningCsrModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api := r.OktaIDaaSClient.OktaSDKClientV5().ApplicationCredentialsAPI
+	csr, apiResp, err := api.GetCsrForApplication(ctx, data.AppID.ValueString(), data.ID.ValueString()).Execute()
+	if err != nil {
+		if apiResp == nil || apiResp.StatusCode != http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				"Error reading SAML app signing CSR",
+				"Could not read SAML app signing CSR, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		// Okta removes a CSR once a certificate is published for it, keep the
+		// CSR in state as long as the app holds the resulting key.
+		if data.Csr.ValueString() != "" {
+			keys, keysResp, err := api.ListApplicationKeys(ctx, data.AppID.ValueString()).Execute()
+			if err != nil && (keysResp == nil || keysResp.StatusCode != http.StatusNotFound) {
+				resp.Diagnostics.AddError(
+					"Error reading SAML app signing CSR",
+					"Could not list keys of the SAML app, unexpected error: "+err.Error(),
+				)
+				return
+			}
+			if key, _ := FindAppKeyForCsr(data.Csr.ValueString(), keys); key != nil {
+				return
+			}
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(applyAppSamlSigningCsrToState(&data, csr)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appSamlSigningCsrResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// all arguments force a new CSR
+	var data appSamlSigningCsrModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *appSamlSigningCsrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data appSamlSigningCsrModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// published CSRs are already gone, the key itself stays on the app
+	apiResp, err := r.OktaIDaaSClient.OktaSDKClientV5().ApplicationCredentialsAPI.RevokeCsrFromApplication(ctx, data.AppID.ValueString(), data.ID.ValueString()).Execute()
+	if err := utils.SuppressErrorOn404_V5(apiResp, err); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting SAML app signing CSR",
+			"Could not revoke SAML app signing CSR, unexpected error: "+err.Error(),
+		)
+	}
+}
+
+func applyAppSamlSigningCsrToState(data *appSamlSigningCsrModel, csr *v5okta.Csr) (diags diag.Diagnostics) {
+	data.ID = types.StringValue(csr.GetId())
+	data.Kty = types.StringValue(csr.GetKty())
+	if csr.Created != nil {
+		data.Created = types.StringValue(csr.Created.Format(time.RFC3339))
+	} else {
+		data.Created = types.StringNull()
+	}
+	data.Csr = types.StringValue(csr.GetCsr())
+	req, err := parseAppCsr(csr.GetCsr())
+	if err != nil {
+		diags.AddError("Invalid CSR", "Okta returned a CSR that could not be parsed: "+err.Error())
+		return diags
+	}
+	data.CsrPEM = types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: req.Raw})))
+
+	// subject and dns_names are only unset after an import
+	if data.Subject == nil {
+		data.Subject = &appSamlSigningCsrSubjectModel{
+			CommonName:             stringValueOrNull(req.Subject.CommonName),
+			CountryName:            firstStringValueOrNull(req.Subject.Country),
+			LocalityName:           firstStringValueOrNull(req.Subject.Locality),
+			OrganizationName:       firstStringValueOrNull(req.Subject.Organization),
+			OrganizationalUnitName: firstStringValueOrNull(req.Subject.OrganizationalUnit),
+			StateOrProvinceName:    firstStringValueOrNull(req.Subject.Province),
+		}
+	}
+	if data.DNSNames.IsNull() && len(req.DNSNames) > 0 {
+		dnsNames, d := types.ListValueFrom(context.Background(), types.StringType, req.DNSNames)
+		diags.Append(d...)
+		data.DNSNames = dnsNames
+	}
+	return diags
+}
+
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func firstStringValueOrNull(s []string) types.String {
+	if len(s) == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(s[0])
+}
+
+// AppCsrPEM converts a CSR as returned by Okta, base64 encoded DER, into PEM.
+// PEM input is returned re-encoded.
+func AppCsrPEM(csr string) (string, error) {
+	req, err := parseAppCsr(csr)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: req.Raw})), nil
+}
+
+func parseAppCsr(csr string) (*x509.CertificateRequest, error) {
+	csr = strings.TrimSpace(csr)
+	var der []byte
+	if block, _ := pem.Decode([]byte(csr)); block != nil {
+		der = block.Bytes
+	} else {
+		var err error
+		der, err = base64.StdEncoding.DecodeString(csr)
+		if err != nil {
+			return nil, fmt.Errorf("CSR is neither PEM nor base64 encoded DER: %w", err)
+		}
+	}
+	req, err := x509.ParseCertificateRequest(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSR: %w", err)
+	}
+	return req, nil
+}
+
+// FindAppKeyForCsr returns the key of an app whose public key is the public
+// key of the given CSR, or nil when the CSR has not been published.
+func FindAppKeyForCsr(csr string, keys []v5okta.JsonWebKey) (*v5okta.JsonWebKey, error) {
+	req, err := parseAppCsr(csr)
+	if err != nil {
+		return nil, err
+	}
+	for i := range keys {
+		pub, err := appKeyPublicKey(&keys[i])
+		if err != nil {
+			continue
+		}
+		if publicKeysEqual(req.PublicKey, pub) {
+			return &keys[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func appKeyPublicKey(key *v5okta.JsonWebKey) (crypto.PublicKey, error) {
+	if key == nil {
+		return nil, errors.New("key is nil")
+	}
+	if len(key.X5c) > 0 {
+		der, err := base64.StdEncoding.DecodeString(key.X5c[0])
+		if err != nil {
+			return nil, err
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	}
+	if key.GetKty() == "RSA" && key.GetN() != "" && key.GetE() != "" {
+		n, err := base64.RawURLEncoding.DecodeString(key.GetN())
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.GetE())
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	}
+	return nil, fmt.Errorf("key %q has no usable public key material", key.GetKid())
+}
+
+func publicKeysEqual(a, b crypto.PublicKey) bool {
+	k, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && k.Equal(b)
+}
@@ -0,0 +1,122 @@
+package idaas_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v5okta "github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+	"github.com/okta/terraform-provider-okta/okta/services/idaas"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// generateOktaCsr mimics Okta generating a key pair for an app and returning
+// the CSR for it as base64 encoded DER.
+func generateOktaCsr(t *testing.T) (*rsa.PrivateKey, string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: "SAML signing", Organization: []string{"Example"}},
+		DNSNames: []string{"example.okta.com"},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, base64.StdEncoding.EncodeToString(der)
+}
+
+func TestAppCsrPEM(t *testing.T) {
+	_, csr := generateOktaCsr(t)
+	csrPEM, err := idaas.AppCsrPEM(csr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(csrPEM, "-----BEGIN CERTIFICATE REQUEST-----") {
+		t.Errorf("unexpected PEM %q", csrPEM)
+	}
+	// PEM input is accepted as well
+	again, err := idaas.AppCsrPEM(csrPEM)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if again != csrPEM {
+		t.Error("expected PEM to round trip")
+	}
+	if _, err = idaas.AppCsrPEM("not a csr"); err == nil {
+		t.Error("expected error for invalid CSR")
+	}
+}
+
+func TestFindAppKeyForCsr(t *testing.T) {
+	key, csr := generateOktaCsr(t)
+	other, _ := generateOktaCsr(t)
+
+	keys := []v5okta.JsonWebKey{
+		{
+			Kid: utils.StringPtr("other"),
+			Kty: utils.StringPtr("RSA"),
+			N:   utils.StringPtr(base64.RawURLEncoding.EncodeToString(other.N.Bytes())),
+			E:   utils.StringPtr("AQAB"),
+		},
+		{
+			Kid: utils.StringPtr("published"),
+			Kty: utils.StringPtr("RSA"),
+			N:   utils.StringPtr(base64.RawURLEncoding.EncodeToString(key.N.Bytes())),
+			E:   utils.StringPtr("AQAB"),
+		},
+	}
+	found, err := idaas.FindAppKeyForCsr(csr, keys)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if found == nil || found.GetKid() != "published" {
+		t.Errorf("expected key published, got %+v", found)
+	}
+
+	found, err = idaas.FindAppKeyForCsr(csr, keys[:1])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if found != nil {
+		t.Errorf("expected no key, got %+v", found)
+	}
+}
+
+func TestAccResourceOktaAppSamlSigningCsr_crud(t *testing.T) {
+	mgr := newFixtureManager("resources", resources.OktaIDaaSAppSamlSigningCsr, t.Name())
+	config := mgr.GetFixtures("basic.tf", t)
+	resourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSAppSamlSigningCsr)
+	appResourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSAppSaml)
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		CheckDestroy:             checkResourceDestroy(resources.OktaIDaaSAppSaml, createDoesAppExist(sdk.NewSamlApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "app_id", appResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "subject.common_name", "SAML signing"),
+					resource.TestCheckResourceAttr(resourceName, "dns_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kty", "RSA"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "csr"),
+					resource.TestCheckResourceAttrSet(resourceName, "csr_pem"),
+				),
+			},
+		},
+	})
+}
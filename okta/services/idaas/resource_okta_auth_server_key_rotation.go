@@ -0,0 +1,330 @@
+package idaas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	v6okta "github.com/okta/okta-sdk-golang/v6/okta"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+var (
+	_ resource.Resource                = &authServerKeyRotationResource{}
+	_ resource.ResourceWithConfigure   = &authServerKeyRotationResource{}
+	_ resource.ResourceWithImportState = &authServerKeyRotationResource{}
+)
+
+type authServerKeyRotationResource struct {
+	*config.Config
+}
+
+type authServerKeyRotationModel struct {
+	ID                 types.String `tfsdk:"id"`
+	AuthServerID       types.String `tfsdk:"auth_server_id"`
+	RotationTrigger    types.String `tfsdk:"rotation_trigger"`
+	RotationDays       types.Int64  `tfsdk:"rotation_days"`
+	WaitForJwks        types.Bool   `tfsdk:"wait_for_jwks"`
+	WaitForJwksTimeout types.Int64  `tfsdk:"wait_for_jwks_timeout"`
+	ActiveKid          types.String `tfsdk:"active_kid"`
+	NextKid            types.String `tfsdk:"next_kid"`
+	LastRotated        types.String `tfsdk:"last_rotated"`
+	NextRotation       types.String `tfsdk:"next_rotation"`
+}
+
+func newAuthServerKeyRotationResource() resource.Resource {
+	return &authServerKeyRotationResource{}
+}
+
+func (r *authServerKeyRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_server_key_rotation"
+}
+
+func (r *authServerKeyRotationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *authServerKeyRotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("auth_server_id"), req.ID)...)
+}
+
+func (r *authServerKeyRotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates the signing keys of an authorization server. The keys are rotated when the resource is created and every time it is replaced, which happens when `rotation_trigger` changes or, like the `time_rotating` resource, once `rotation_days` have passed since the last rotation. Rotating makes the `NEXT` key the `ACTIVE` key, tokens signed with the previous key fail validation once clients refresh their JWKS. Destroying this resource does not change the keys.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the authorization server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auth_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the authorization server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value, the keys are rotated whenever it changes. Can be set to the `id` of a `time_rotating` resource or any other value that changes when a rotation is due.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_days": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of days after which the keys are rotated again. When the period has passed the resource is removed from state on refresh and the next apply rotates the keys.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"wait_for_jwks": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Wait after a rotation until the new active key is published in the JWKS of the authorization server. Default: `false`.",
+			},
+			"wait_for_jwks_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(300),
+				Description: "Number of seconds to wait for the new key to appear in the JWKS when `wait_for_jwks` is set, a warning is reported when it does not. Default: `300`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"active_kid": schema.StringAttribute{
+				Computed:    true,
+				Description: "The kid of the `ACTIVE` key that signs tokens.",
+			},
+			"next_kid": schema.StringAttribute{
+				Computed:    true,
+				Description: "The kid of the `NEXT` key that becomes active on the next rotation.",
+			},
+			"last_rotated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the rotation done by this resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"next_rotation": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp after which the keys are rotated again, only set with `rotation_days`.",
+			},
+		},
+	}
+}
+
+func (r *authServerKeyRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data authServerKeyRotationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authServerID := data.AuthServerID.ValueString()
+	use := v6okta.NewJwkUse()
+	use.SetUse("sig")
+	keys, _, err := r.OktaIDaaSClient.OktaSDKClientV6().AuthorizationServerKeysAPI.RotateAuthorizationServerKeys(ctx, authServerID).Use(*use).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rotating authorization server keys",
+			"Could not rotate keys of authorization server "+authServerID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(authServerID)
+	data.LastRotated = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	applyAuthServerKeysToState(&data, keys)
+	setAuthServerKeyNextRotation(&data)
+	// track the rotation before waiting, the keys have been rotated either way
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// an error would taint the resource and rotate the keys once more on the
+	// next apply, the rotation itself succeeded
+	if data.WaitForJwks.ValueBool() && data.ActiveKid.ValueString() != "" {
+		if err = r.waitForJwksKid(ctx, authServerID, data.ActiveKid.ValueString(), time.Duration(data.WaitForJwksTimeout.ValueInt64())*time.Second); err != nil {
+			resp.Diagnostics.AddWarning(
+				"Authorization server JWKS not updated yet",
+				"The keys were rotated but the new active key did not appear in the JWKS: "+err.Error(),
+			)
+		}
+	}
+}
+
+func (r *authServerKeyRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data authServerKeyRotationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if AuthServerKeyRotationDue(data.LastRotated.ValueString(), data.RotationDays.ValueInt64(), time.Now()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	keys, apiResp, err := r.OktaIDaaSClient.OktaSDKClientV6().AuthorizationServerKeysAPI.ListAuthorizationServerKeys(ctx, data.AuthServerID.ValueString()).Execute()
+	if err != nil {
+		if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading authorization server keys",
+			"Could not list keys of authorization server, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	applyAuthServerKeysToState(&data, keys)
+	if data.WaitForJwks.IsNull() {
+		data.WaitForJwks = types.BoolValue(false)
+	}
+	if data.WaitForJwksTimeout.IsNull() {
+		data.WaitForJwksTimeout = types.Int64Value(300)
+	}
+	if data.LastRotated.IsNull() {
+		// imported, nothing has been rotated by this resource yet
+		data.LastRotated = types.StringValue("")
+	}
+	setAuthServerKeyNextRotation(&data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *authServerKeyRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// rotation_trigger and auth_server_id force a new resource, the other
+	// arguments only change how the next rotation is done
+	var state, data authServerKeyRotationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+	data.ActiveKid = state.ActiveKid
+	data.NextKid = state.NextKid
+	data.LastRotated = state.LastRotated
+	setAuthServerKeyNextRotation(&data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *authServerKeyRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// there is nothing to undo, the keys stay as they are
+}
+
+func (r *authServerKeyRotationResource) waitForJwksKid(ctx context.Context, authServerID, kid string, timeout time.Duration) error {
+	authServer, _, err := r.OktaIDaaSClient.OktaSDKClientV6().AuthorizationServerAPI.GetAuthorizationServer(ctx, authServerID).Execute()
+	if err != nil {
+		return err
+	}
+	jwksURL := strings.TrimSuffix(authServer.GetIssuer(), "/") + "/v1/keys"
+	httpClient := r.OktaIDaaSClient.OktaSDKClientV2().GetConfig().HttpClient
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURL, nil)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		res, err := httpClient.Do(req)
+		if err != nil {
+			return retry.RetryableError(err)
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return retry.RetryableError(err)
+		}
+		if res.StatusCode != http.StatusOK {
+			return retry.RetryableError(fmt.Errorf("GET %s returned status %d", jwksURL, res.StatusCode))
+		}
+		found, err := JwksContainsKid(body, kid)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if !found {
+			return retry.RetryableError(fmt.Errorf("key %s is not yet published in %s", kid, jwksURL))
+		}
+		return nil
+	})
+}
+
+func applyAuthServerKeysToState(data *authServerKeyRotationModel, keys []v6okta.AuthorizationServerJsonWebKey) {
+	data.ActiveKid = types.StringValue("")
+	data.NextKid = types.StringValue("")
+	for _, key := range keys {
+		switch key.GetStatus() {
+		case StatusActive:
+			data.ActiveKid = types.StringValue(key.GetKid())
+		case "NEXT":
+			data.NextKid = types.StringValue(key.GetKid())
+		}
+	}
+}
+
+func setAuthServerKeyNextRotation(data *authServerKeyRotationModel) {
+	data.NextRotation = types.StringNull()
+	if data.RotationDays.IsNull() || data.LastRotated.ValueString() == "" {
+		return
+	}
+	lastRotated, err := time.Parse(time.RFC3339, data.LastRotated.ValueString())
+	if err != nil {
+		return
+	}
+	data.NextRotation = types.StringValue(lastRotated.AddDate(0, 0, int(data.RotationDays.ValueInt64())).Format(time.RFC3339))
+}
+
+// AuthServerKeyRotationDue reports whether rotationDays have passed since
+// lastRotated, an RFC 3339 timestamp. It never is without rotationDays or
+// without a prior rotation.
+func AuthServerKeyRotationDue(lastRotated string, rotationDays int64, now time.Time) bool {
+	if rotationDays <= 0 || lastRotated == "" {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, lastRotated)
+	if err != nil {
+		return false
+	}
+	return !now.Before(t.AddDate(0, 0, int(rotationDays)))
+}
+
+// JwksContainsKid reports whether a JWKS document lists a key with the kid.
+func JwksContainsKid(jwks []byte, kid string) (bool, error) {
+	var doc struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(jwks, &doc); err != nil {
+		return false, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+	for _, key := range doc.Keys {
+		if key.Kid == kid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
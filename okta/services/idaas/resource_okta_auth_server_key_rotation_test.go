@@ -0,0 +1,94 @@
+package idaas_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+	"github.com/okta/terraform-provider-okta/okta/services/idaas"
+)
+
+func TestAuthServerKeyRotationDue(t *testing.T) {
+	lastRotated := "2026-01-01T00:00:00Z"
+	tests := []struct {
+		name         string
+		lastRotated  string
+		rotationDays int64
+		now          time.Time
+		want         bool
+	}{
+		{"within period", lastRotated, 30, time.Date(2026, 1, 30, 23, 59, 59, 0, time.UTC), false},
+		{"period passed", lastRotated, 30, time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), true},
+		{"no rotation days", lastRotated, 0, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"imported", "", 30, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := idaas.AuthServerKeyRotationDue(tt.lastRotated, tt.rotationDays, tt.now); got != tt.want {
+				t.Errorf("AuthServerKeyRotationDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJwksContainsKid(t *testing.T) {
+	jwks := []byte(`{"keys":[{"kty":"RSA","kid":"active","use":"sig"},{"kty":"RSA","kid":"next","use":"sig"}]}`)
+	found, err := idaas.JwksContainsKid(jwks, "next")
+	if err != nil || !found {
+		t.Errorf("expected kid next to be found, got %v, %v", found, err)
+	}
+	found, err = idaas.JwksContainsKid(jwks, "other")
+	if err != nil || found {
+		t.Errorf("expected kid other not to be found, got %v, %v", found, err)
+	}
+	if _, err = idaas.JwksContainsKid([]byte("<html>"), "active"); err == nil {
+		t.Error("expected error for invalid JWKS")
+	}
+}
+
+func TestAccResourceOktaAuthServerKeyRotation_crud(t *testing.T) {
+	mgr := newFixtureManager("resources", resources.OktaIDaaSAuthServerKeyRotation, t.Name())
+	config := mgr.GetFixtures("basic.tf", t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", t)
+	resourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSAuthServerKeyRotation)
+	authServerResourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSAuthServer)
+
+	var activeKid string
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		CheckDestroy:             checkResourceDestroy(resources.OktaIDaaSAuthServer, authServerExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "auth_server_id", authServerResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "active_kid"),
+					resource.TestCheckResourceAttrSet(resourceName, "next_kid"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_rotated"),
+					func(s *terraform.State) error {
+						activeKid = s.RootModule().Resources[resourceName].Primary.Attributes["active_kid"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "active_kid"),
+					func(s *terraform.State) error {
+						if kid := s.RootModule().Resources[resourceName].Primary.Attributes["active_kid"]; kid == activeKid {
+							return fmt.Errorf("expected active kid to change after rotation, still %s", kid)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,174 @@
+package idaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &customizedErrorPageResource{}
+	_ resource.ResourceWithConfigure   = &customizedErrorPageResource{}
+	_ resource.ResourceWithImportState = &customizedErrorPageResource{}
+)
+
+func newCustomizedErrorPageResource() resource.Resource {
+	return &customizedErrorPageResource{}
+}
+
+type customizedErrorPageResource struct {
+	*config.Config
+}
+
+func (r *customizedErrorPageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customized_error_page"
+}
+
+func (r *customizedErrorPageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	oktaMutexKV.Lock(resources.OktaIDaaSCustomizedErrorPage)
+	defer oktaMutexKV.Unlock(resources.OktaIDaaSCustomizedErrorPage)
+
+	// Clone the shared schema's Attributes map to avoid mutating the global
+	// `resourceErrorPageSchema`.
+	newSchema := resourceErrorPageSchema
+	newAttrs := make(map[string]resourceSchema.Attribute, len(resourceErrorPageSchema.Attributes))
+	for k, v := range resourceErrorPageSchema.Attributes {
+		newAttrs[k] = v
+	}
+	newSchema.Attributes = newAttrs
+
+	pageContentAttribute := newSchema.Attributes["page_content"].(resourceSchema.StringAttribute)
+	pageContentAttribute.Required = false
+	pageContentAttribute.Optional = true
+	newSchema.Attributes["page_content"] = pageContentAttribute
+	newSchema.Description = "Manage the customized error page of a brand"
+	resp.Schema = newSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *customizedErrorPageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *customizedErrorPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	oktaMutexKV.Lock(resources.OktaIDaaSCustomizedErrorPage)
+	defer oktaMutexKV.Unlock(resources.OktaIDaaSCustomizedErrorPage)
+
+	var state errorPageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody, diags := buildErrorPageRequest(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customizedErrorPage, _, err := r.OktaIDaaSClient.OktaSDKClientV3().CustomizationAPI.ReplaceCustomizedErrorPage(ctx, state.BrandID.ValueString()).ErrorPage(reqBody).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to update customized error page",
+			err.Error(),
+		)
+		return
+	}
+
+	mapErrorPageToState(customizedErrorPage, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *customizedErrorPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state errorPageModel
+
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var brandID string
+	if state.BrandID.ValueString() != "" {
+		brandID = state.BrandID.ValueString()
+	} else {
+		brandID = state.ID.ValueString()
+	}
+
+	customizedErrorPage, _, err := r.OktaIDaaSClient.OktaSDKClientV3().CustomizationAPI.GetCustomizedErrorPage(ctx, brandID).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error retrieving customized error page",
+			fmt.Sprintf("Error returned: %s", err.Error()),
+		)
+		return
+	}
+
+	mapErrorPageToState(customizedErrorPage, &state)
+
+	// Save data into state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *customizedErrorPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	oktaMutexKV.Lock(resources.OktaIDaaSCustomizedErrorPage)
+	defer oktaMutexKV.Unlock(resources.OktaIDaaSCustomizedErrorPage)
+
+	var state errorPageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.OktaIDaaSClient.OktaSDKClientV3().CustomizationAPI.DeleteCustomizedErrorPage(ctx, state.ID.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to delete customized error page",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *customizedErrorPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	oktaMutexKV.Lock(resources.OktaIDaaSCustomizedErrorPage)
+	defer oktaMutexKV.Unlock(resources.OktaIDaaSCustomizedErrorPage)
+
+	var state errorPageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody, diags := buildErrorPageRequest(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customizedErrorPage, _, err := r.OktaIDaaSClient.OktaSDKClientV3().CustomizationAPI.ReplaceCustomizedErrorPage(ctx, state.BrandID.ValueString()).ErrorPage(reqBody).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to update customized error page",
+			err.Error(),
+		)
+		return
+	}
+
+	mapErrorPageToState(customizedErrorPage, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *customizedErrorPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("brand_id"), req.ID)...)
+}
@@ -0,0 +1,64 @@
+package idaas_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccResourceOktaCustomizedErrorPage_crud(t *testing.T) {
+	mgr := newFixtureManager("resources", resources.OktaIDaaSCustomizedErrorPage, t.Name())
+	resourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSCustomizedErrorPage)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		CheckDestroy:             nil,
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: mgr.GetFixtures("basic.tf", t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "brand_id", "okta_brand.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "page_content"),
+					resource.TestCheckNoResourceAttr(resourceName, "content_security_policy_setting"),
+				),
+			},
+			{
+				Config: mgr.GetFixtures("update.tf", t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "page_content"),
+					resource.TestCheckResourceAttr(resourceName, "content_security_policy_setting.mode", "report_only"),
+					resource.TestCheckResourceAttr(resourceName, "content_security_policy_setting.report_uri", ""),
+					resource.TestCheckResourceAttr(resourceName, "content_security_policy_setting.src_list.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return rs.Primary.Attributes["brand_id"], nil
+				},
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return errors.New("failed to import resource into state")
+					}
+					if s[0].Attributes["brand_id"] == "" {
+						return errors.New("brand_id is empty after import")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
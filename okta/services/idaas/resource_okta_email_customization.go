@@ -2,7 +2,9 @@ package idaas
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v4/okta"
@@ -16,6 +18,22 @@ func resourceEmailCustomization() *schema.Resource {
 		UpdateContext: resourceEmailCustomizationUpdate,
 		DeleteContext: resourceEmailCustomizationDelete,
 		Importer:      utils.CreateNestedResourceImporter([]string{"id", "brand_id", "template_name"}),
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if d.Get("from_default_content").(bool) && d.HasChange("language") && d.Id() != "" {
+				// the default content follows the language
+				for _, attr := range []string{"subject", "body"} {
+					if d.GetRawConfig().GetAttr(attr).IsNull() {
+						if err := d.SetNewComputed(attr); err != nil {
+							return err
+						}
+					}
+				}
+			}
+			return nil
+		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validateEmailCustomizationVariables,
+		},
 		Description: `Create an email customization of an email template belonging to a brand in an Okta organization.
 		Use this resource to create an [email
 		customization](https://developer.okta.com/docs/reference/api/brands/#create-email-customization)
@@ -49,6 +67,41 @@ func resourceEmailCustomization() *schema.Resource {
 	}
 }
 
+// validateEmailCustomizationVariables warns about variables referenced in the
+// configured subject and body that the provider doesn't know for the template.
+// The variable lists are maintained by hand, so a valid variable may be
+// missing and the plan isn't failed.
+func validateEmailCustomizationVariables(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	config := req.RawConfig
+	if !config.IsKnown() || config.IsNull() {
+		return
+	}
+	if validate := config.GetAttr("validate_variables"); validate.IsKnown() && !validate.IsNull() && validate.False() {
+		return
+	}
+	// template_name could be interpolated from other resources and not be
+	// known until apply
+	templateName := config.GetAttr("template_name")
+	if !templateName.IsKnown() || templateName.IsNull() {
+		return
+	}
+	for _, attr := range []string{"subject", "body"} {
+		// only the configured values are checked, not the default content
+		value := config.GetAttr(attr)
+		if value.IsNull() || !value.IsKnown() {
+			continue
+		}
+		if err := ValidateEmailTemplateVariables(templateName.AsString(), value.AsString()); err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "Unknown email template variables",
+				Detail:        fmt.Sprintf("%s: %s", attr, err.Error()),
+				AttributePath: cty.GetAttrPath(attr),
+			})
+		}
+	}
+}
+
 func resourceEmailCustomizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	brandID, ok := d.GetOk("brand_id")
 	if !ok {
@@ -77,6 +130,12 @@ func resourceEmailCustomizationCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	client := getOktaV3ClientFromMetadata(meta)
+	if d.Get("from_default_content").(bool) {
+		err := applyEmailDefaultContent(ctx, client, d, brandID.(string), templateName.(string), &etcr)
+		if err != nil {
+			return diag.Errorf("failed to create email customization: %v", err)
+		}
+	}
 
 	customization, _, err := client.CustomizationAPI.CreateEmailCustomization(ctx, brandID.(string), templateName.(string)).Instance(etcr).Execute()
 	if err != nil {
@@ -136,6 +195,12 @@ func resourceEmailCustomizationUpdate(ctx context.Context, d *schema.ResourceDat
 	if body, ok := d.GetOk("body"); ok {
 		cr.Body = body.(string)
 	}
+	if d.Get("from_default_content").(bool) {
+		err := applyEmailDefaultContent(ctx, getOktaV3ClientFromMetadata(meta), d, etcr.brandID, etcr.templateName, &cr)
+		if err != nil {
+			return diag.Errorf("failed to update email customization: %v", err)
+		}
+	}
 
 	customization, _, err := getOktaV3ClientFromMetadata(meta).CustomizationAPI.ReplaceEmailCustomization(ctx, etcr.brandID, etcr.templateName, d.Id()).Instance(cr).Execute()
 	if err != nil {
@@ -206,3 +271,28 @@ func etcrValues(action string, d *schema.ResourceData) (*etcrHelper, diag.Diagno
 		templateName: templateName.(string),
 	}, nil
 }
+
+// applyEmailDefaultContent sets the subject and body that are not configured
+// to the default content of the template in the customization's language.
+func applyEmailDefaultContent(ctx context.Context, client *okta.APIClient, d *schema.ResourceData, brandID, templateName string, customization *okta.EmailCustomization) error {
+	subjectSet := !d.GetRawConfig().GetAttr("subject").IsNull()
+	bodySet := !d.GetRawConfig().GetAttr("body").IsNull()
+	if subjectSet && bodySet {
+		return nil
+	}
+	req := client.CustomizationAPI.GetEmailDefaultContent(ctx, brandID, templateName)
+	if customization.Language != "" {
+		req = req.Language(customization.Language)
+	}
+	content, _, err := req.Execute()
+	if err != nil {
+		return fmt.Errorf("failed to get default content of email template %s: %v", templateName, err)
+	}
+	if !subjectSet {
+		customization.Subject = content.GetSubject()
+	}
+	if !bodySet {
+		customization.Body = content.GetBody()
+	}
+	return nil
+}
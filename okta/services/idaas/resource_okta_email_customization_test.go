@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/okta/terraform-provider-okta/okta/acctest"
 	"github.com/okta/terraform-provider-okta/okta/resources"
+	"github.com/okta/terraform-provider-okta/okta/services/idaas"
 )
 
 // TestAccResourceOktaEmailCustomization_crud demonstrates having a default `en`
@@ -158,3 +159,82 @@ func checkResourceEmailCustomizationDestroy(s *terraform.State) error {
 	}
 	return nil
 }
+
+// TestAccResourceOktaEmailCustomization_unknownVariables checks that a variable
+// the provider doesn't know for the template only warns, the plan succeeds.
+func TestAccResourceOktaEmailCustomization_unknownVariables(t *testing.T) {
+	mgr := newFixtureManager("resources", resources.OktaIDaaSEmailCustomization, t.Name())
+	config := mgr.GetFixtures("unknown_variables.tf", t)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		CheckDestroy:             checkResourceEmailCustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestValidateEmailTemplateVariables(t *testing.T) {
+	tests := []struct {
+		name         string
+		templateName string
+		text         string
+		err          string
+	}{
+		{
+			name:         "template variable",
+			templateName: "ForgotPassword",
+			text:         "Click ${resetPasswordLink} or use $!{recoveryToken}",
+		},
+		{
+			name:         "namespaces",
+			templateName: "UserActivation",
+			text:         "Hi ${user.profile.firstName}, welcome to ${org.name} ${f.formatTimeDiffHoursNowInUserLocale(${org.activationTokenExpirationHours})} ${baseURL}",
+		},
+		{
+			name:         "velocity definitions",
+			templateName: "UserActivation",
+			text:         "#set($greeting = \"Hi\")${greeting} #foreach(${group} in ${user.groups.names})${group}#end ${activationLink}",
+		},
+		{
+			name:         "no braces are not checked",
+			templateName: "UserActivation",
+			text:         "$resetPasswordLink",
+		},
+		{
+			name:         "unknown template",
+			templateName: "IGAReviewerNotification",
+			text:         "${campaign.name}",
+		},
+		{
+			name:         "variable of another template",
+			templateName: "UserActivation",
+			text:         "${activationLink} ${resetPasswordLink} ${resetPasswordLink}",
+			err:          "variables resetPasswordLink are not available in email template UserActivation, expected one of baseURL, activationLink, activationToken, app.*, brand.*, f.*, org.*, request.*, user.*",
+		},
+		{
+			name:         "typos",
+			templateName: "PasswordChanged",
+			text:         "${usr.profile.login} ${orgName}",
+			err:          "variables orgName, usr are not available in email template PasswordChanged, expected one of baseURL, app.*, brand.*, f.*, org.*, request.*, user.*",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := idaas.ValidateEmailTemplateVariables(tc.templateName, tc.text)
+			if tc.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.err != "" && (err == nil || err.Error() != tc.err) {
+				t.Fatalf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,453 @@
+package idaas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/okta-sdk-golang/v4/okta"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+)
+
+var (
+	_ resource.Resource                   = &emailCustomizationsResource{}
+	_ resource.ResourceWithConfigure      = &emailCustomizationsResource{}
+	_ resource.ResourceWithImportState    = &emailCustomizationsResource{}
+	_ resource.ResourceWithValidateConfig = &emailCustomizationsResource{}
+)
+
+type emailCustomizationsResource struct {
+	*config.Config
+}
+
+type emailCustomizationsModel struct {
+	ID                 types.String                            `tfsdk:"id"`
+	BrandID            types.String                            `tfsdk:"brand_id"`
+	TemplateName       types.String                            `tfsdk:"template_name"`
+	DefaultLanguage    types.String                            `tfsdk:"default_language"`
+	FromDefaultContent types.Bool                              `tfsdk:"from_default_content"`
+	Recipients         types.String                            `tfsdk:"recipients"`
+	ValidateVariables  types.Bool                              `tfsdk:"validate_variables"`
+	Customizations     []emailCustomizationsCustomizationModel `tfsdk:"customization"`
+	CustomizationIDs   types.Map                               `tfsdk:"customization_ids"`
+}
+
+type emailCustomizationsCustomizationModel struct {
+	Language types.String `tfsdk:"language"`
+	Subject  types.String `tfsdk:"subject"`
+	Body     types.String `tfsdk:"body"`
+}
+
+func newEmailCustomizationsResource() resource.Resource {
+	return &emailCustomizationsResource{}
+}
+
+func (r *emailCustomizationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_customizations"
+}
+
+func (r *emailCustomizationsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *emailCustomizationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError("Invalid Import Identifier", "Expected import identifier with format <brand_id>/<template_name>")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("brand_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_name"), idParts[1])...)
+}
+
+func (r *emailCustomizationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages every language variant of an email template of a brand. Customizations in languages that are not configured are deleted. " +
+			"Do not combine with `okta_email_customization` resources for the same template.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the resource. This is a compound ID of the brand ID and the template name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"brand_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the brand.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the email template.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_language": schema.StringAttribute{
+				Required:    true,
+				Description: "The language of the default customization, one of the `customization` languages.",
+			},
+			"from_default_content": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Use Okta's default content of the template, in the customization's language, for the `subject` and `body` a `customization` does not set. Default: `false`.",
+			},
+			"recipients": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The recipients the emails of this template will be sent to - Valid values: `ALL_USERS`, `ADMINS_ONLY`, `NO_USERS`. Left as is when not set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(emailTemplateRecipients...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"validate_variables": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Warn at plan time about `${...}` and `$!{...}` variables referenced in the configured subjects and bodies that the provider does not know for the email template. Defaults to `true`.",
+			},
+			"customization_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the customizations, keyed by language.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"customization": schema.SetNestedBlock{
+				Description: "A language variant of the template.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"language": schema.StringAttribute{
+							Required:    true,
+							Description: "The language of the customization, an IETF BCP 47 language tag.",
+						},
+						"subject": schema.StringAttribute{
+							Optional:    true,
+							Description: "The subject of the customization. Required unless `from_default_content` is set.",
+						},
+						"body": schema.StringAttribute{
+							Optional:    true,
+							Description: "The body of the customization. Required unless `from_default_content` is set.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *emailCustomizationsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data emailCustomizationsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	languages := map[string]bool{}
+	for _, customization := range data.Customizations {
+		if customization.Language.IsUnknown() {
+			return
+		}
+		language := customization.Language.ValueString()
+		if languages[language] {
+			resp.Diagnostics.AddAttributeError(path.Root("customization"), "Duplicate language", fmt.Sprintf("language %q is set on more than one customization", language))
+		}
+		languages[language] = true
+		if !data.FromDefaultContent.ValueBool() && !data.FromDefaultContent.IsUnknown() &&
+			(customization.Subject.IsNull() || customization.Body.IsNull()) {
+			resp.Diagnostics.AddAttributeError(path.Root("customization"), "Missing content", fmt.Sprintf("subject and body of the %q customization are required unless from_default_content is set", language))
+		}
+		if data.ValidateVariables.IsNull() || data.ValidateVariables.ValueBool() {
+			for attr, value := range map[string]types.String{"subject": customization.Subject, "body": customization.Body} {
+				if value.IsNull() || value.IsUnknown() || data.TemplateName.IsUnknown() {
+					continue
+				}
+				if err := ValidateEmailTemplateVariables(data.TemplateName.ValueString(), value.ValueString()); err != nil {
+					// the variable lists are maintained by hand, a valid variable may be missing
+					resp.Diagnostics.AddAttributeWarning(path.Root("customization"), "Unknown email template variables", fmt.Sprintf("%s of the %q customization: %s", attr, language, err.Error()))
+				}
+			}
+		}
+	}
+	if !data.DefaultLanguage.IsUnknown() && !languages[data.DefaultLanguage.ValueString()] {
+		resp.Diagnostics.AddAttributeError(path.Root("default_language"), "Invalid default language", fmt.Sprintf("default_language %q must be the language of a customization", data.DefaultLanguage.ValueString()))
+	}
+}
+
+func (r *emailCustomizationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan emailCustomizationsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(formatId(plan.BrandID.ValueString(), plan.TemplateName.ValueString()))
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *emailCustomizationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state emailCustomizationsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.OktaIDaaSClient.OktaSDKClientV3().CustomizationAPI
+	brandID, templateName := state.BrandID.ValueString(), state.TemplateName.ValueString()
+	customizations, apiResp, err := listEmailCustomizations(ctx, r.OktaIDaaSClient.OktaSDKClientV3(), brandID, templateName)
+	if err != nil {
+		if apiResp != nil && apiResp.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading email customizations", "Could not list email customizations, unexpected error: "+err.Error())
+		return
+	}
+	if len(customizations) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	actual := map[string]okta.EmailCustomization{}
+	ids := map[string]string{}
+	for _, customization := range customizations {
+		actual[customization.Language] = customization
+		ids[customization.Language] = customization.GetId()
+		if customization.GetIsDefault() {
+			state.DefaultLanguage = types.StringValue(customization.Language)
+		}
+	}
+
+	// only the configured subjects and bodies are tracked, the ones taken
+	// from the default content are left to Okta
+	var read []emailCustomizationsCustomizationModel
+	for _, customization := range state.Customizations {
+		current, ok := actual[customization.Language.ValueString()]
+		if !ok {
+			continue
+		}
+		if !customization.Subject.IsNull() {
+			customization.Subject = types.StringValue(current.Subject)
+		}
+		if !customization.Body.IsNull() {
+			customization.Body = types.StringValue(current.Body)
+		}
+		read = append(read, customization)
+		delete(actual, current.Language)
+	}
+	// customizations created outside of Terraform, deleted on the next apply
+	languages := make([]string, 0, len(actual))
+	for language := range actual {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	for _, language := range languages {
+		read = append(read, emailCustomizationsCustomizationModel{
+			Language: types.StringValue(language),
+			Subject:  types.StringValue(actual[language].Subject),
+			Body:     types.StringValue(actual[language].Body),
+		})
+	}
+	state.Customizations = read
+
+	var diags diag.Diagnostics
+	state.CustomizationIDs, diags = types.MapValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+
+	settings, _, err := client.GetEmailSettings(ctx, brandID, templateName).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading email template settings", "Could not read email template settings, unexpected error: "+err.Error())
+		return
+	}
+	state.Recipients = types.StringValue(settings.Recipients)
+	if state.FromDefaultContent.IsNull() {
+		state.FromDefaultContent = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *emailCustomizationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan emailCustomizationsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *emailCustomizationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state emailCustomizationsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.OktaIDaaSClient.OktaSDKClientV3().CustomizationAPI.DeleteAllCustomizations(ctx, state.BrandID.ValueString(), state.TemplateName.ValueString()).Execute()
+	if err := utils.SuppressErrorOn404_V3(apiResp, err); err != nil {
+		resp.Diagnostics.AddError("Error deleting email customizations", "Could not delete email customizations, unexpected error: "+err.Error())
+	}
+}
+
+// apply makes the customizations of the template match the plan. The default
+// customization is written first, so the customizations of other languages
+// can be deleted after.
+func (r *emailCustomizationsResource) apply(ctx context.Context, plan *emailCustomizationsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := r.OktaIDaaSClient.OktaSDKClientV3()
+	brandID, templateName := plan.BrandID.ValueString(), plan.TemplateName.ValueString()
+
+	if !plan.Recipients.IsUnknown() && !plan.Recipients.IsNull() {
+		settings := okta.EmailSettings{}
+		settings.SetRecipients(plan.Recipients.ValueString())
+		if _, err := client.CustomizationAPI.ReplaceEmailSettings(ctx, brandID, templateName).EmailSettings(settings).Execute(); err != nil {
+			diags.AddError("Error updating email template settings", "Could not update email template settings, unexpected error: "+err.Error())
+			return diags
+		}
+	}
+
+	existing, _, err := listEmailCustomizations(ctx, client, brandID, templateName)
+	if err != nil {
+		diags.AddError("Error reading email customizations", "Could not list email customizations, unexpected error: "+err.Error())
+		return diags
+	}
+	ids := map[string]string{}
+	for _, customization := range existing {
+		ids[customization.Language] = customization.GetId()
+	}
+
+	customizations := sortEmailCustomizationsByDefault(plan.Customizations, plan.DefaultLanguage.ValueString())
+	for _, customization := range customizations {
+		language := customization.Language.ValueString()
+		isDefault := language == plan.DefaultLanguage.ValueString()
+		body := okta.EmailCustomization{
+			Language:  language,
+			Subject:   customization.Subject.ValueString(),
+			Body:      customization.Body.ValueString(),
+			IsDefault: utils.BoolPtr(isDefault),
+		}
+		if plan.FromDefaultContent.ValueBool() && (customization.Subject.IsNull() || customization.Body.IsNull()) {
+			content, _, err := client.CustomizationAPI.GetEmailDefaultContent(ctx, brandID, templateName).Language(language).Execute()
+			if err != nil {
+				diags.AddError("Error reading email default content", fmt.Sprintf("Could not read default content of %s in %q, unexpected error: %s", templateName, language, err.Error()))
+				return diags
+			}
+			if customization.Subject.IsNull() {
+				body.Subject = content.GetSubject()
+			}
+			if customization.Body.IsNull() {
+				body.Body = content.GetBody()
+			}
+		}
+
+		if id, ok := ids[language]; ok {
+			if _, _, err := client.CustomizationAPI.ReplaceEmailCustomization(ctx, brandID, templateName, id).Instance(body).Execute(); err != nil {
+				diags.AddError("Error updating email customization", fmt.Sprintf("Could not update the %q customization, unexpected error: %s", language, err.Error()))
+				return diags
+			}
+			continue
+		}
+		// a new customization can only be the default when it is the first
+		// one, an existing default is switched by updating after creation
+		body.IsDefault = utils.BoolPtr(isDefault && len(ids) == 0)
+		created, _, err := client.CustomizationAPI.CreateEmailCustomization(ctx, brandID, templateName).Instance(body).Execute()
+		if err != nil {
+			diags.AddError("Error creating email customization", fmt.Sprintf("Could not create the %q customization, unexpected error: %s", language, err.Error()))
+			return diags
+		}
+		ids[language] = created.GetId()
+		if isDefault && !created.GetIsDefault() {
+			body.IsDefault = utils.BoolPtr(true)
+			if _, _, err := client.CustomizationAPI.ReplaceEmailCustomization(ctx, brandID, templateName, created.GetId()).Instance(body).Execute(); err != nil {
+				diags.AddError("Error updating email customization", fmt.Sprintf("Could not make the %q customization the default, unexpected error: %s", language, err.Error()))
+				return diags
+			}
+		}
+	}
+
+	planned := map[string]bool{}
+	for _, customization := range plan.Customizations {
+		planned[customization.Language.ValueString()] = true
+	}
+	for language, id := range ids {
+		if planned[language] {
+			continue
+		}
+		apiResp, err := client.CustomizationAPI.DeleteEmailCustomization(ctx, brandID, templateName, id).Execute()
+		if err := utils.SuppressErrorOn404_V3(apiResp, err); err != nil {
+			diags.AddError("Error deleting email customization", fmt.Sprintf("Could not delete the %q customization, unexpected error: %s", language, err.Error()))
+			return diags
+		}
+		delete(ids, language)
+	}
+
+	idsValue, d := types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	plan.CustomizationIDs = idsValue
+	if plan.Recipients.IsUnknown() || plan.Recipients.IsNull() {
+		settings, _, err := client.CustomizationAPI.GetEmailSettings(ctx, brandID, templateName).Execute()
+		if err != nil {
+			diags.AddError("Error reading email template settings", "Could not read email template settings, unexpected error: "+err.Error())
+			return diags
+		}
+		plan.Recipients = types.StringValue(settings.Recipients)
+	}
+	return diags
+}
+
+// sortEmailCustomizationsByDefault returns the customizations with the one of the default
+// language first.
+func sortEmailCustomizationsByDefault(customizations []emailCustomizationsCustomizationModel, defaultLanguage string) []emailCustomizationsCustomizationModel {
+	sorted := make([]emailCustomizationsCustomizationModel, 0, len(customizations))
+	for _, customization := range customizations {
+		if customization.Language.ValueString() == defaultLanguage {
+			sorted = append([]emailCustomizationsCustomizationModel{customization}, sorted...)
+		} else {
+			sorted = append(sorted, customization)
+		}
+	}
+	return sorted
+}
+
+func listEmailCustomizations(ctx context.Context, client *okta.APIClient, brandID, templateName string) ([]okta.EmailCustomization, *okta.APIResponse, error) {
+	customizations, resp, err := client.CustomizationAPI.ListEmailCustomizations(ctx, brandID, templateName).Execute()
+	if err != nil {
+		return nil, resp, err
+	}
+	for resp.HasNextPage() {
+		var more []okta.EmailCustomization
+		resp, err = resp.Next(&more)
+		if err != nil {
+			return nil, resp, err
+		}
+		customizations = append(customizations, more...)
+	}
+	return customizations, resp, nil
+}
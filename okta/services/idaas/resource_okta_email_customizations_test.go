@@ -0,0 +1,87 @@
+package idaas_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccResourceOktaEmailCustomizations_crud(t *testing.T) {
+	resourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSEmailCustomizations)
+	mgr := newFixtureManager("resources", resources.OktaIDaaSEmailCustomizations, t.Name())
+	config := mgr.GetFixtures("basic.tf", t)
+	updatedConfig := mgr.GetFixtures("updated.tf", t)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		CheckDestroy:             checkResourceEmailCustomizationsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "template_name", "ForgotPassword"),
+					resource.TestCheckResourceAttr(resourceName, "default_language", "en"),
+					resource.TestCheckResourceAttr(resourceName, "recipients", "ALL_USERS"),
+					resource.TestCheckResourceAttr(resourceName, "customization.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "customization_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "customization_ids.en"),
+					resource.TestCheckResourceAttrSet(resourceName, "customization_ids.es"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_language", "fr"),
+					resource.TestCheckResourceAttr(resourceName, "recipients", "ADMINS_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "customization.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "customization_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "customization_ids.en"),
+					resource.TestCheckResourceAttrSet(resourceName, "customization_ids.fr"),
+					resource.TestCheckNoResourceAttr(resourceName, "customization_ids.es"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"from_default_content", "validate_variables", "customization"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["brand_id"], rs.Primary.Attributes["template_name"]), nil
+				},
+			},
+		},
+	})
+}
+
+func checkResourceEmailCustomizationsDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resources.OktaIDaaSEmailCustomizations {
+			continue
+		}
+		brandID := rs.Primary.Attributes["brand_id"]
+		templateName := rs.Primary.Attributes["template_name"]
+		t := &testing.T{}
+		client := IDaaSClientForTest(t).OktaSDKClientV3()
+
+		customizations, _, err := client.CustomizationAPI.ListEmailCustomizations(context.Background(), brandID, templateName).Execute()
+		if err != nil {
+			return fmt.Errorf("failed to list email customizations, brandID %q, templateName: %q", brandID, templateName)
+		}
+		if len(customizations) > 0 {
+			return fmt.Errorf("email customizations still exist, brandID %q, templateName: %q", brandID, templateName)
+		}
+	}
+	return nil
+}
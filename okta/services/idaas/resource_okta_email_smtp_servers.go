@@ -2,9 +2,13 @@ package idaas
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	v5okta "github.com/okta/okta-sdk-golang/v5/okta"
 	"github.com/okta/terraform-provider-okta/okta/utils"
 )
@@ -18,8 +22,11 @@ func resourceEmailSMTP() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Description: `configure a custom external email provider to send email notifications. 
+		Description: `configure a custom external email provider to send email notifications.
 		By default, notifications such as the welcome email or an account recovery email are sent through an Okta-managed SMTP server.`,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("password"), cty.GetAttrPath("password_wo")),
+		},
 		Schema: map[string]*schema.Schema{
 			"host": {
 				Type:        schema.TypeString,
@@ -37,37 +44,79 @@ func resourceEmailSMTP() *schema.Resource {
 				Description: "Display name of the email domain.",
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "User name of the email domain.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+				Description:  "Password used to access your SMTP server. When set, this password will be stored in the Terraform state file. For Terraform 1.11+, consider using `password_wo` instead to avoid persisting it in state.",
+			},
+			"password_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+				Description:  "Write-only password used to access your SMTP server for Terraform 1.11+. Unlike `password`, this password will not be persisted in the Terraform state file. Only use this attribute with Terraform 1.11 or higher.",
+			},
+			"password_wo_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Version number for the write-only password. Increment this value to trigger an update when changing `password_wo`.",
 			},
 			"alias": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Human-readable name for your SMTP server.",
+				Description: "Human-readable name for your SMTP server. Must be unique among the SMTP servers of the org.",
 			},
 			"enabled": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "If true, routes all email traffic through your SMTP server.",
+				Description: "If true, routes all email traffic through your SMTP server. Only one SMTP server of the org can be enabled at a time.",
+			},
+			"verify_on_apply": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Send a test email through the SMTP server whenever it is created or its connection settings change, and fail the apply if the test fails.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Email address that sends the test email.",
+						},
+						"to": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Email address that receives the test email.",
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
 func resourceEmailSMTPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	emailSMTPResp, _, err := getOktaV5ClientFromMetadata(meta).EmailServerAPI.CreateEmailServer(ctx).EmailServerPost(buildEmailSMTP(d)).Execute()
+	client := getOktaV5ClientFromMetadata(meta)
+	if err := checkEmailSMTPServerConflicts(ctx, client, d); err != nil {
+		return diag.Errorf("failed to create email SMTP server: %v", err)
+	}
+	emailSMTPResp, _, err := client.EmailServerAPI.CreateEmailServer(ctx).EmailServerPost(buildEmailSMTP(d)).Execute()
 	if err != nil {
-		return nil
+		return diag.Errorf("failed to create email SMTP server: %v", err)
+	}
+	d.SetId(emailSMTPResp.GetId())
+	if err := verifyEmailSMTPServer(ctx, client, d); err != nil {
+		return diag.FromErr(err)
 	}
-	d.SetId(*emailSMTPResp.Id)
 	return resourceEmailSMTPRead(ctx, d, meta)
 }
 
 func resourceEmailSMTPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	emailSMTP, resp, err := getOktaV5ClientFromMetadata(meta).EmailServerAPI.GetEmailServer(ctx, d.Id()).Execute()
 	if err := utils.SuppressErrorOn404_V5(resp, err); err != nil {
-		return diag.Errorf("failed to get email domain: %v", err)
+		return diag.Errorf("failed to get email SMTP server: %v", err)
 	}
 	if resp == nil || resp.StatusCode == 404 {
 		d.SetId("")
@@ -78,21 +127,33 @@ func resourceEmailSMTPRead(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("emailSMTPServer is nil but no 404 returned")
 	}
 
-	properties := emailSMTP.AdditionalProperties
+	server, err := emailSMTPServerFromResponse(emailSMTP)
+	if err != nil {
+		return diag.Errorf("failed to read email SMTP server: %v", err)
+	}
 
-	_ = d.Set("host", properties["host"].(string))
-	_ = d.Set("alias", properties["alias"].(string))
-	_ = d.Set("enabled", properties["enabled"].(bool))
-	_ = d.Set("username", properties["username"].(string))
-	_ = d.Set("port", properties["port"].(float64))
+	_ = d.Set("host", server.GetHost())
+	_ = d.Set("alias", server.GetAlias())
+	_ = d.Set("enabled", server.GetEnabled())
+	_ = d.Set("username", server.GetUsername())
+	_ = d.Set("port", server.GetPort())
 	return nil
 }
 
 func resourceEmailSMTPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := getOktaV5ClientFromMetadata(meta)
+	if err := checkEmailSMTPServerConflicts(ctx, client, d); err != nil {
+		return diag.Errorf("failed to update email SMTP server: %v", err)
+	}
 	req := buildEmailServerRequest(d)
-	_, _, err := getOktaV5ClientFromMetadata(meta).EmailServerAPI.UpdateEmailServer(ctx, d.Id()).EmailServerRequest(req).Execute()
+	_, _, err := client.EmailServerAPI.UpdateEmailServer(ctx, d.Id()).EmailServerRequest(req).Execute()
 	if err != nil {
-		return nil
+		return diag.Errorf("failed to update email SMTP server: %v", err)
+	}
+	if d.HasChanges("host", "port", "username", "password", "password_wo_version", "verify_on_apply") {
+		if err := verifyEmailSMTPServer(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 	return resourceEmailSMTPRead(ctx, d, meta)
 }
@@ -100,18 +161,90 @@ func resourceEmailSMTPUpdate(ctx context.Context, d *schema.ResourceData, meta i
 func resourceEmailSMTPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, err := getOktaV5ClientFromMetadata(meta).EmailServerAPI.DeleteEmailServer(ctx, d.Id()).Execute()
 	if err != nil {
-		return diag.Errorf("failed to delete email domain: %v", err)
+		return diag.Errorf("failed to delete email SMTP server: %v", err)
 	}
 	return nil
 }
 
+// checkEmailSMTPServerConflicts keeps several okta_email_smtp_server
+// resources from fighting each other: aliases must be unique and enabling a
+// server in Okta silently disables the one that was enabled before, which
+// would show up as a perpetual diff on the other resource.
+func checkEmailSMTPServerConflicts(ctx context.Context, client *v5okta.APIClient, d *schema.ResourceData) error {
+	checkEnabled := d.Get("enabled").(bool) && (d.IsNewResource() || d.HasChange("enabled"))
+	checkAlias := d.IsNewResource() || d.HasChange("alias")
+	if !checkEnabled && !checkAlias {
+		return nil
+	}
+	servers, _, err := client.EmailServerAPI.ListEmailServers(ctx).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list email SMTP servers: %v", err)
+	}
+	alias := d.Get("alias").(string)
+	for _, server := range servers.EmailServers {
+		if server.GetId() == d.Id() {
+			continue
+		}
+		if checkAlias && server.GetAlias() == alias {
+			return fmt.Errorf("email SMTP server %s already uses alias %q, aliases must be unique", server.GetId(), alias)
+		}
+		if checkEnabled && server.GetEnabled() {
+			return fmt.Errorf("email SMTP server %q (%s) is already enabled, only one SMTP server can be enabled at a time; set 'enabled' to false on it first", server.GetAlias(), server.GetId())
+		}
+	}
+	return nil
+}
+
+// verifyEmailSMTPServer sends a test email through the server when
+// verify_on_apply is configured.
+func verifyEmailSMTPServer(ctx context.Context, client *v5okta.APIClient, d *schema.ResourceData) error {
+	verify, ok := d.GetOk("verify_on_apply")
+	if !ok {
+		return nil
+	}
+	addresses := verify.([]interface{})[0].(map[string]interface{})
+	_, err := client.EmailServerAPI.TestEmailServer(ctx, d.Id()).
+		EmailTestAddresses(*v5okta.NewEmailTestAddresses(addresses["from"].(string), addresses["to"].(string))).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("email SMTP server %s failed the connection test: %v", d.Id(), err)
+	}
+	return nil
+}
+
+// emailSMTPServerFromResponse returns the server of a get email server
+// response. The API returns a single server while the SDK decodes it as a
+// list, so its fields end up in AdditionalProperties.
+func emailSMTPServerFromResponse(resp *v5okta.EmailServerListResponse) (*v5okta.EmailServerResponse, error) {
+	if len(resp.EmailServers) > 0 {
+		return &resp.EmailServers[0], nil
+	}
+	b, err := json.Marshal(resp.AdditionalProperties)
+	if err != nil {
+		return nil, err
+	}
+	var server v5okta.EmailServerResponse
+	if err := json.Unmarshal(b, &server); err != nil {
+		return nil, err
+	}
+	return &server, nil
+}
+
+func emailSMTPPassword(d *schema.ResourceData) string {
+	woVal, _ := d.GetRawConfigAt(cty.GetAttrPath("password_wo"))
+	if !woVal.IsNull() && woVal.IsKnown() {
+		return woVal.AsString()
+	}
+	return d.Get("password").(string)
+}
+
 func buildEmailSMTP(d *schema.ResourceData) v5okta.EmailServerPost {
 	return v5okta.EmailServerPost{
 		Alias:    d.Get("alias").(string),
 		Host:     d.Get("host").(string),
 		Port:     int32(d.Get("port").(int)),
 		Username: d.Get("username").(string),
-		Password: d.Get("password").(string),
+		Password: emailSMTPPassword(d),
 		Enabled:  utils.BoolPtr(d.Get("enabled").(bool)),
 	}
 }
@@ -122,7 +255,7 @@ func buildEmailServerRequest(d *schema.ResourceData) v5okta.EmailServerRequest {
 		Host:     interfaceToStringPointer(d.Get("host")),
 		Port:     interfaceToInt32Pointer(d.Get("port")),
 		Username: interfaceToStringPointer(d.Get("username")),
-		Password: interfaceToStringPointer(d.Get("password")),
+		Password: interfaceToStringPointer(emailSMTPPassword(d)),
 		Enabled:  utils.BoolPtr(d.Get("enabled").(bool)),
 	}
 }
@@ -12,6 +12,7 @@ import (
 func TestAccResourceOktaSMTPServer_crud(t *testing.T) {
 	mgr := newFixtureManager("resources", resources.OktaIDaaSEmailSMTPServer, t.Name())
 	config := mgr.GetFixtures("basic.tf", t)
+	passwordWOConfig := mgr.GetFixtures("password_wo.tf", t)
 	resourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSEmailSMTPServer)
 	acctest.OktaResourceTest(t, resource.TestCase{
 		PreCheck:                 acctest.AccPreCheck(t),
@@ -28,6 +29,15 @@ func TestAccResourceOktaSMTPServer_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "alias", "CustomisedServer"),
 				),
 			},
+			{
+				Config: passwordWOConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "host", "192.168.2.0"),
+					resource.TestCheckResourceAttr(resourceName, "password", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "1"),
+				),
+			},
 		},
 	})
 }
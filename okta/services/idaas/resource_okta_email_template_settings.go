@@ -5,15 +5,20 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/okta/okta-sdk-golang/v4/okta"
 	"github.com/okta/terraform-provider-okta/okta/config"
 )
 
+// emailTemplateRecipients are the recipients an email template can be sent to
+var emailTemplateRecipients = []string{"ALL_USERS", "ADMINS_ONLY", "NO_USERS"}
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &emailTemplateSettingsResource{}
@@ -62,6 +67,9 @@ func (r *emailTemplateSettingsResource) Schema(_ context.Context, _ resource.Sch
 			"recipients": schema.StringAttribute{
 				Description: "The recipients the emails of this template will be sent to - Valid values: `ALL_USERS`, `ADMINS_ONLY`, `NO_USERS`",
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(emailTemplateRecipients...),
+				},
 			},
 		},
 	}
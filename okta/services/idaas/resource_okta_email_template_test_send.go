@@ -0,0 +1,170 @@
+package idaas
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+var (
+	_ resource.Resource                   = &emailTemplateTestSendResource{}
+	_ resource.ResourceWithConfigure      = &emailTemplateTestSendResource{}
+	_ resource.ResourceWithValidateConfig = &emailTemplateTestSendResource{}
+)
+
+type emailTemplateTestSendResource struct {
+	*config.Config
+}
+
+type emailTemplateTestSendModel struct {
+	ID              types.String `tfsdk:"id"`
+	BrandID         types.String `tfsdk:"brand_id"`
+	TemplateName    types.String `tfsdk:"template_name"`
+	CustomizationID types.String `tfsdk:"customization_id"`
+	Language        types.String `tfsdk:"language"`
+	Triggers        types.Map    `tfsdk:"triggers"`
+	SentLanguage    types.String `tfsdk:"sent_language"`
+	SentAt          types.String `tfsdk:"sent_at"`
+}
+
+func newEmailTemplateTestSendResource() resource.Resource {
+	return &emailTemplateTestSendResource{}
+}
+
+func (r *emailTemplateTestSendResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_template_test_send"
+}
+
+func (r *emailTemplateTestSendResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *emailTemplateTestSendResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends a test email of an email template to the primary and secondary email addresses of the admin the provider is authenticated as. " +
+			"The email is sent when the resource is created and every time it is replaced, which happens when any argument, e.g. `triggers`, changes. " +
+			"Customized test emails are only sent when a custom email domain is configured, otherwise Okta sends the default template from the Okta email domain. Destroying this resource does nothing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the email template.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"brand_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the brand.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the email template.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"customization_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the customization to send, e.g. the `id` of an `okta_email_customization`. Conflicts with `language`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"language": schema.StringAttribute{
+				Optional:    true,
+				Description: "The language of the customization to send. When neither `language` nor `customization_id` is set, Okta sends the default customization, or the default content in the admin's language.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary map of values, the test email is sent again whenever it changes, e.g. `{ body = okta_email_customization.example.body }`.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"sent_language": schema.StringAttribute{
+				Computed:    true,
+				Description: "The language requested for the test email, empty when Okta picked it.",
+			},
+			"sent_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the test email sent by this resource.",
+			},
+		},
+	}
+}
+
+func (r *emailTemplateTestSendResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data emailTemplateTestSendModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.CustomizationID.IsNull() && !data.Language.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("language"), "Conflicting attributes", "language can not be set together with customization_id, the test email uses the language of the customization.")
+	}
+}
+
+func (r *emailTemplateTestSendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data emailTemplateTestSendModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.OktaIDaaSClient.OktaSDKClientV3().CustomizationAPI
+	brandID, templateName := data.BrandID.ValueString(), data.TemplateName.ValueString()
+	language := data.Language.ValueString()
+	if customizationID := data.CustomizationID.ValueString(); customizationID != "" {
+		// the test endpoint selects the customization by its language
+		customization, _, err := client.GetEmailCustomization(ctx, brandID, templateName, customizationID).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading email customization", "Could not read email customization "+customizationID+", unexpected error: "+err.Error())
+			return
+		}
+		language = customization.GetLanguage()
+	}
+
+	testReq := client.SendTestEmail(ctx, brandID, templateName)
+	if language != "" {
+		testReq = testReq.Language(language)
+	}
+	if _, err := testReq.Execute(); err != nil {
+		resp.Diagnostics.AddError("Error sending test email", "Could not send test email of template "+templateName+", unexpected error: "+err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(templateName)
+	data.SentLanguage = types.StringValue(language)
+	data.SentAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailTemplateTestSendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// a sent email has no state in Okta
+}
+
+func (r *emailTemplateTestSendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// every argument forces a new resource
+	var data emailTemplateTestSendModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *emailTemplateTestSendResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// there is nothing to undo, the email has been sent
+}
@@ -0,0 +1,33 @@
+package idaas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccResourceOktaEmailTemplateTestSend_crud(t *testing.T) {
+	mgr := newFixtureManager("resources", resources.OktaIDaaSEmailTemplateTestSend, t.Name())
+	config := mgr.GetFixtures("basic.tf", t)
+	resourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSEmailTemplateTestSend)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		CheckDestroy:             checkResourceEmailCustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "ForgotPassword"),
+					resource.TestCheckResourceAttr(resourceName, "sent_language", "en"),
+					resource.TestCheckResourceAttrSet(resourceName, "sent_at"),
+				),
+			},
+		},
+	})
+}
@@ -2,13 +2,29 @@ package idaas
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v6okta "github.com/okta/okta-sdk-golang/v6/okta"
 	"github.com/okta/terraform-provider-okta/okta/utils"
-	"github.com/okta/terraform-provider-okta/sdk"
 )
 
+var eventHookFilterSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"event": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The event type the condition applies to. Must be one of the hook's `events`.",
+		},
+		"condition": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Okta Expression Language condition an event of this type must satisfy to be delivered, e.g. `event.target.?[type eq 'AppUser'].size() > 0`.",
+		},
+	},
+}
+
 func resourceEventHook() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceEventHookCreate,
@@ -18,6 +34,17 @@ func resourceEventHook() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// events or filter could be interpolated from other resources and
+			// not be known until apply
+			if !d.NewValueKnown("events") || !d.NewValueKnown("filter") {
+				return nil
+			}
+			return ValidateEventHookFilter(
+				utils.ConvertInterfaceToStringSetNullable(d.Get("events")),
+				eventHookFilterEvents(d.Get("filter").(*schema.Set)),
+			)
+		},
 		Description: "Creates an event hook. This resource allows you to create and configure an event hook.",
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -32,6 +59,12 @@ func resourceEventHook() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "The events that will be delivered to this hook. [See here for a list of supported events](https://developer.okta.com/docs/reference/api/event-types/?q=event-hook-eligible).",
 			},
+			"filter": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        eventHookFilterSchema,
+				Description: "Okta Expression Language conditions that narrow which events are delivered to this hook. At most one condition per event type; event types without a condition are delivered unfiltered.",
+			},
 			"headers": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -50,6 +83,7 @@ func resourceEventHook() *schema.Resource {
 					}
 					return false
 				},
+				ConflictsWith: []string{"channel_oauth2"},
 				Description: `Details of the endpoint the event hook will hit.   
 	- 'version' - (Required) The version of the channel. The currently-supported version is '1.0.0'.
 	- 'uri' - (Required) The URI the hook will hit.
@@ -69,19 +103,30 @@ func resourceEventHook() *schema.Resource {
 				},
 				Description: "Details of the endpoint the event hook will hit.",
 			},
+			"channel_oauth2": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Elem:          hookOAuth2Schema,
+				ConflictsWith: []string{"auth"},
+				Description:   "OAuth 2.0 authentication of the `channel`, the channel type becomes `OAUTH`.",
+			},
 		},
 	}
 }
 
 func resourceEventHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := getOktaClientFromMetadata(meta)
-	hook := buildEventHook(d)
-	newHook, _, err := client.EventHook.CreateEventHook(ctx, *hook)
+	client := getOktaV6ClientFromMetadata(meta)
+	hook, err := buildEventHook(d)
+	if err != nil {
+		return diag.Errorf("failed to create event hook: %v", err)
+	}
+	newHook, _, err := client.EventHookAPI.CreateEventHook(ctx).EventHook(*hook).Execute()
 	if err != nil {
 		return diag.Errorf("failed to create event hook: %v", err)
 	}
-	d.SetId(newHook.Id)
-	err = setEventHookStatus(ctx, d, client, newHook.Status)
+	d.SetId(newHook.GetId())
+	err = setEventHookStatus(ctx, d, client, newHook.GetStatus())
 	if err != nil {
 		return diag.Errorf("failed to set event hook status: %v", err)
 	}
@@ -89,8 +134,8 @@ func resourceEventHookCreate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceEventHookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	hook, resp, err := getOktaClientFromMetadata(meta).EventHook.GetEventHook(ctx, d.Id())
-	if err := utils.SuppressErrorOn404(resp, err); err != nil {
+	hook, resp, err := getOktaV6ClientFromMetadata(meta).EventHookAPI.GetEventHook(ctx, d.Id()).Execute()
+	if err := utils.SuppressErrorOn404_V6(resp, err); err != nil {
 		return diag.Errorf("failed to get event hook: %v", err)
 	}
 	if hook == nil {
@@ -98,12 +143,14 @@ func resourceEventHookRead(ctx context.Context, d *schema.ResourceData, meta int
 		return nil
 	}
 	_ = d.Set("name", hook.Name)
-	_ = d.Set("status", hook.Status)
+	_ = d.Set("status", hook.GetStatus())
 	_ = d.Set("events", EventSet(hook.Events))
 	err = utils.SetNonPrimitives(d, map[string]interface{}{
-		"channel": flattenEventHookChannel(hook.Channel),
-		"headers": flattenEventHookHeaders(hook.Channel),
-		"auth":    flattenEventHookAuth(d, hook.Channel),
+		"filter":         flattenEventHookFilter(hook.Events),
+		"channel":        flattenEventHookChannel(hook.Channel),
+		"headers":        flattenEventHookHeaders(hook.Channel),
+		"auth":           flattenEventHookAuth(d, hook.Channel),
+		"channel_oauth2": flattenEventHookOAuth2(d, hook.Channel),
 	})
 	if err != nil {
 		return diag.Errorf("failed to set event hook properties: %v", err)
@@ -112,13 +159,16 @@ func resourceEventHookRead(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceEventHookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := getOktaClientFromMetadata(meta)
-	hook := buildEventHook(d)
-	newHook, _, err := client.EventHook.UpdateEventHook(ctx, d.Id(), *hook)
+	client := getOktaV6ClientFromMetadata(meta)
+	hook, err := buildEventHook(d)
+	if err != nil {
+		return diag.Errorf("failed to update auth event hook: %v", err)
+	}
+	newHook, _, err := client.EventHookAPI.ReplaceEventHook(ctx, d.Id()).EventHook(*hook).Execute()
 	if err != nil {
 		return diag.Errorf("failed to update auth event hook: %v", err)
 	}
-	err = setEventHookStatus(ctx, d, client, newHook.Status)
+	err = setEventHookStatus(ctx, d, client, newHook.GetStatus())
 	if err != nil {
 		return diag.Errorf("failed to set event hook status: %v", err)
 	}
@@ -126,63 +176,132 @@ func resourceEventHookUpdate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceEventHookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := getOktaClientFromMetadata(meta)
+	client := getOktaV6ClientFromMetadata(meta)
 
-	_, _, err := client.EventHook.DeactivateEventHook(ctx, d.Id())
+	_, _, err := client.EventHookAPI.DeactivateEventHook(ctx, d.Id()).Execute()
 	if err != nil {
 		return diag.Errorf("failed to deactivate event hook: %v", err)
 	}
-	_, err = client.EventHook.DeleteEventHook(ctx, d.Id())
+	_, err = client.EventHookAPI.DeleteEventHook(ctx, d.Id()).Execute()
 	if err != nil {
 		return diag.Errorf("failed to delete event hook: %v", err)
 	}
 	return nil
 }
 
-func buildEventHook(d *schema.ResourceData) *sdk.EventHook {
+func buildEventHook(d *schema.ResourceData) (*v6okta.EventHook, error) {
 	eventSet := d.Get("events").(*schema.Set).List()
 	events := make([]string, len(eventSet))
 	for i, v := range eventSet {
 		events[i] = v.(string)
 	}
-	return &sdk.EventHook{
+	channel, err := buildEventChannel(d)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions := v6okta.EventSubscriptions{Type: "EVENT_TYPE", Items: events}
+	if filter := buildEventHookFilter(d); filter != nil {
+		subscriptions.SetFilter(*filter)
+	}
+	return &v6okta.EventHook{
 		Name:    d.Get("name").(string),
-		Status:  d.Get("status").(string),
-		Events:  &sdk.EventSubscriptions{Type: "EVENT_TYPE", Items: events},
-		Channel: buildEventChannel(d),
+		Status:  utils.StringPtr(d.Get("status").(string)),
+		Events:  subscriptions,
+		Channel: *channel,
+	}, nil
+}
+
+func buildEventHookFilter(d *schema.ResourceData) *v6okta.EventHookFilters {
+	rawFilters := d.Get("filter").(*schema.Set).List()
+	if len(rawFilters) == 0 {
+		return nil
+	}
+	filter := &v6okta.EventHookFilters{
+		Type:           utils.StringPtr("EXPRESSION_LANGUAGE"),
+		EventFilterMap: make([]v6okta.EventHookFilterMapObject, len(rawFilters)),
+	}
+	for i, raw := range rawFilters {
+		f := raw.(map[string]interface{})
+		filter.EventFilterMap[i] = v6okta.EventHookFilterMapObject{
+			Event: utils.StringPtr(f["event"].(string)),
+			Condition: &v6okta.EventHookFilterMapObjectCondition{
+				Expression: utils.StringPtr(f["condition"].(string)),
+			},
+		}
+	}
+	return filter
+}
+
+func eventHookFilterEvents(filters *schema.Set) []string {
+	events := make([]string, 0, filters.Len())
+	for _, raw := range filters.List() {
+		if f, ok := raw.(map[string]interface{}); ok {
+			events = append(events, f["event"].(string))
+		}
+	}
+	return events
+}
+
+// ValidateEventHookFilter checks that every filtered event type is one of the
+// hook's subscribed events and has at most one condition.
+func ValidateEventHookFilter(events, filterEvents []string) error {
+	subscribed := make(map[string]bool, len(events))
+	for _, event := range events {
+		subscribed[event] = true
+	}
+	seen := make(map[string]bool, len(filterEvents))
+	for _, event := range filterEvents {
+		if !subscribed[event] {
+			return fmt.Errorf("filter event %q is not one of the hook's events", event)
+		}
+		if seen[event] {
+			return fmt.Errorf("filter event %q has more than one condition, combine them into a single expression", event)
+		}
+		seen[event] = true
 	}
+	return nil
 }
 
-func buildEventChannel(d *schema.ResourceData) *sdk.EventHookChannel {
-	var headerList []*sdk.EventHookChannelConfigHeader
+func buildEventChannel(d *schema.ResourceData) (*v6okta.EventHookChannel, error) {
+	var headerList []v6okta.EventHookChannelConfigHeader
 	if raw, ok := d.GetOk("headers"); ok {
 		for _, header := range raw.(*schema.Set).List() {
 			h, ok := header.(map[string]interface{})
 			if ok {
-				headerList = append(headerList, &sdk.EventHookChannelConfigHeader{Key: h["key"].(string), Value: h["value"].(string)})
+				headerList = append(headerList, v6okta.EventHookChannelConfigHeader{
+					Key:   utils.StringPtr(h["key"].(string)),
+					Value: utils.StringPtr(h["value"].(string)),
+				})
 			}
 		}
 	}
-	var auth *sdk.EventHookChannelConfigAuthScheme
+	var auth *v6okta.EventHookChannelConfigAuthScheme
 	if rawAuth, ok := d.GetOk("auth"); ok {
 		a := rawAuth.(map[string]interface{})
 		_, ok := a["type"]
 		if !ok {
 			a["type"] = "HEADER"
 		}
-		auth = &sdk.EventHookChannelConfigAuthScheme{
-			Key:   a["key"].(string),
-			Type:  a["type"].(string),
-			Value: a["value"].(string),
+		auth = &v6okta.EventHookChannelConfigAuthScheme{
+			Key:   utils.StringPtr(a["key"].(string)),
+			Type:  utils.StringPtr(a["type"].(string)),
+			Value: utils.StringPtr(a["value"].(string)),
 		}
 	}
+	oauth2, err := buildHookOAuth2(d)
+	if err != nil {
+		return nil, err
+	}
 	rawChannel := d.Get("channel").(map[string]interface{})
 	_, ok := rawChannel["type"]
 	if !ok {
 		rawChannel["type"] = "HTTP"
+		if oauth2 != nil {
+			rawChannel["type"] = hookOAuth2ChannelType
+		}
 	}
-	return &sdk.EventHookChannel{
-		Config: &sdk.EventHookChannelConfig{
+	channel := &v6okta.EventHookChannel{
+		Config: v6okta.EventHookChannelConfig{
 			Uri:        rawChannel["uri"].(string),
 			AuthScheme: auth,
 			Headers:    headerList,
@@ -190,21 +309,45 @@ func buildEventChannel(d *schema.ResourceData) *sdk.EventHookChannel {
 		Type:    rawChannel["type"].(string),
 		Version: rawChannel["version"].(string),
 	}
+	if oauth2 != nil {
+		channel.Config.AdditionalProperties = hookOAuth2Properties(oauth2)
+	}
+	return channel, nil
 }
 
-func flattenEventHookAuth(d *schema.ResourceData, c *sdk.EventHookChannel) map[string]interface{} {
+func flattenEventHookAuth(d *schema.ResourceData, c v6okta.EventHookChannel) map[string]interface{} {
 	auth := map[string]interface{}{}
 	if c.Config.AuthScheme != nil {
 		auth = map[string]interface{}{
-			"key":   c.Config.AuthScheme.Key,
-			"type":  c.Config.AuthScheme.Type,
+			"key":   c.Config.AuthScheme.GetKey(),
+			"type":  c.Config.AuthScheme.GetType(),
 			"value": d.Get("auth").(map[string]interface{})["value"],
 		}
 	}
 	return auth
 }
 
-func flattenEventHookChannel(c *sdk.EventHookChannel) map[string]interface{} {
+func flattenEventHookOAuth2(d *schema.ResourceData, c v6okta.EventHookChannel) []interface{} {
+	return flattenHookOAuth2(d, c.Type, hookOAuth2FromProperties(c.Config.AdditionalProperties))
+}
+
+func flattenEventHookFilter(e v6okta.EventSubscriptions) *schema.Set {
+	var filters []interface{}
+	if filter := e.Filter.Get(); filter != nil {
+		for _, f := range filter.EventFilterMap {
+			if f.Condition == nil {
+				continue
+			}
+			filters = append(filters, map[string]interface{}{
+				"event":     f.GetEvent(),
+				"condition": f.Condition.GetExpression(),
+			})
+		}
+	}
+	return schema.NewSet(schema.HashResource(eventHookFilterSchema), filters)
+}
+
+func flattenEventHookChannel(c v6okta.EventHookChannel) map[string]interface{} {
 	return map[string]interface{}{
 		"type":    c.Type,
 		"version": c.Version,
@@ -212,18 +355,18 @@ func flattenEventHookChannel(c *sdk.EventHookChannel) map[string]interface{} {
 	}
 }
 
-func flattenEventHookHeaders(c *sdk.EventHookChannel) *schema.Set {
+func flattenEventHookHeaders(c v6okta.EventHookChannel) *schema.Set {
 	headers := make([]interface{}, len(c.Config.Headers))
 	for i, header := range c.Config.Headers {
 		headers[i] = map[string]interface{}{
-			"key":   header.Key,
-			"value": header.Value,
+			"key":   header.GetKey(),
+			"value": header.GetValue(),
 		}
 	}
 	return schema.NewSet(schema.HashResource(HeaderSchema), headers)
 }
 
-func EventSet(e *sdk.EventSubscriptions) *schema.Set {
+func EventSet(e v6okta.EventSubscriptions) *schema.Set {
 	events := make([]interface{}, len(e.Items))
 	for i, event := range e.Items {
 		events[i] = event
@@ -231,16 +374,16 @@ func EventSet(e *sdk.EventSubscriptions) *schema.Set {
 	return schema.NewSet(schema.HashString, events)
 }
 
-func setEventHookStatus(ctx context.Context, d *schema.ResourceData, client *sdk.Client, status string) error {
+func setEventHookStatus(ctx context.Context, d *schema.ResourceData, client *v6okta.APIClient, status string) error {
 	desiredStatus := d.Get("status").(string)
 	if status == desiredStatus {
 		return nil
 	}
 	var err error
 	if desiredStatus == StatusInactive {
-		_, _, err = client.EventHook.DeactivateEventHook(ctx, d.Id())
+		_, _, err = client.EventHookAPI.DeactivateEventHook(ctx, d.Id()).Execute()
 	} else {
-		_, _, err = client.EventHook.ActivateEventHook(ctx, d.Id())
+		_, _, err = client.EventHookAPI.ActivateEventHook(ctx, d.Id()).Execute()
 	}
 	return err
 }
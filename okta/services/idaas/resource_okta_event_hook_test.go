@@ -3,17 +3,18 @@ package idaas_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	v6okta "github.com/okta/okta-sdk-golang/v6/okta"
 	"github.com/okta/terraform-provider-okta/okta/acctest"
 	"github.com/okta/terraform-provider-okta/okta/resources"
 	"github.com/okta/terraform-provider-okta/okta/services/idaas"
 	"github.com/okta/terraform-provider-okta/okta/utils"
-	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func TestAccResourceOktaEventHook_crud(t *testing.T) {
@@ -43,7 +44,7 @@ func TestAccResourceOktaEventHook_crud(t *testing.T) {
 					testCheckResourceSetAttr(
 						resourceName,
 						"events",
-						idaas.EventSet(&sdk.EventSubscriptions{
+						idaas.EventSet(v6okta.EventSubscriptions{
 							Type:  "EVENT_TYPE",
 							Items: []string{"user.lifecycle.create", "user.lifecycle.delete.initiated"},
 						}),
@@ -64,21 +65,21 @@ func TestAccResourceOktaEventHook_crud(t *testing.T) {
 					testCheckResourceSetAttr(
 						resourceName,
 						"headers",
-						testMakeEventHookHeadersSet([]*sdk.EventHookChannelConfigHeader{
+						testMakeEventHookHeadersSet([]v6okta.EventHookChannelConfigHeader{
 							{
-								Key:   "x-test-header",
-								Value: "test stuff",
+								Key:   utils.StringPtr("x-test-header"),
+								Value: utils.StringPtr("test stuff"),
 							},
 							{
-								Key:   "x-another-header",
-								Value: "more test stuff",
+								Key:   utils.StringPtr("x-another-header"),
+								Value: utils.StringPtr("more test stuff"),
 							},
 						}),
 					),
 					testCheckResourceSetAttr(
 						resourceName,
 						"events",
-						idaas.EventSet(&sdk.EventSubscriptions{
+						idaas.EventSet(v6okta.EventSubscriptions{
 							Type: "EVENT_TYPE",
 							Items: []string{
 								"user.lifecycle.create",
@@ -104,7 +105,7 @@ func TestAccResourceOktaEventHook_crud(t *testing.T) {
 					testCheckResourceSetAttr(
 						resourceName,
 						"events",
-						idaas.EventSet(&sdk.EventSubscriptions{
+						idaas.EventSet(v6okta.EventSubscriptions{
 							Type:  "EVENT_TYPE",
 							Items: []string{"user.lifecycle.create", "user.lifecycle.delete.initiated"},
 						}),
@@ -115,6 +116,124 @@ func TestAccResourceOktaEventHook_crud(t *testing.T) {
 	})
 }
 
+func TestAccResourceOktaEventHook_filter(t *testing.T) {
+	resourceName := "okta_event_hook.test"
+	mgr := newFixtureManager("resources", resources.OktaIDaaSEventHook, t.Name())
+	config := mgr.GetFixtures("filter.tf", t)
+	updatedConfig := mgr.GetFixtures("filter_updated.tf", t)
+	invalidConfig := mgr.GetFixtures("filter_invalid.tf", t)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		CheckDestroy:             checkResourceDestroy(resources.OktaIDaaSEventHook, eventHookExists),
+		Steps: []resource.TestStep{
+			{
+				Config:      invalidConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`filter event "group.user_membership.add" is not one of the hook's events`),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, eventHookExists),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"event":     "group.user_membership.add",
+						"condition": "event.target.?[type eq 'UserGroup'].size()>0 && event.target.?[displayName eq 'Sales'].size()>0",
+					}),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, eventHookExists),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"event":     "group.user_membership.add",
+						"condition": "event.target.?[type eq 'UserGroup'].size()>0 && event.target.?[displayName eq 'Marketing'].size()>0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter.*", map[string]string{
+						"event":     "user.lifecycle.create",
+						"condition": "event.target.?[type eq 'User'].size()>0",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the auth value is never returned by the API
+				ImportStateVerifyIgnore: []string{"auth.%", "auth.value"},
+			},
+			{
+				Config: mgr.GetFixtures("basic.tf", t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceOktaEventHook_channelOAuth2(t *testing.T) {
+	resourceName := "okta_event_hook.test"
+	mgr := newFixtureManager("resources", resources.OktaIDaaSEventHook, t.Name())
+	config := mgr.GetFixtures("channel_oauth2.tf", t)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		CheckDestroy:             checkResourceDestroy(resources.OktaIDaaSEventHook, eventHookExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, eventHookExists),
+					resource.TestCheckResourceAttr(resourceName, "channel.type", "OAUTH"),
+					resource.TestCheckResourceAttr(resourceName, "channel_oauth2.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "channel_oauth2.0.auth_type", "client_secret_post"),
+					resource.TestCheckResourceAttr(resourceName, "channel_oauth2.0.client_id", "hook-client"),
+					resource.TestCheckResourceAttr(resourceName, "channel_oauth2.0.token_url", "https://example.com/oauth2/token"),
+					resource.TestCheckResourceAttr(resourceName, "channel_oauth2.0.scope", "hooks.write"),
+					resource.TestCheckNoResourceAttr(resourceName, "channel_oauth2.0.client_secret_wo"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the secret version only exists in configuration
+				ImportStateVerifyIgnore: []string{"channel_oauth2.0.client_secret_wo_version"},
+			},
+		},
+	})
+}
+
+func TestValidateEventHookFilter(t *testing.T) {
+	events := []string{"user.lifecycle.create", "group.user_membership.add"}
+	tests := []struct {
+		name         string
+		filterEvents []string
+		wantErr      bool
+	}{
+		{"no filters", nil, false},
+		{"subscribed events", []string{"user.lifecycle.create", "group.user_membership.add"}, false},
+		{"unsubscribed event", []string{"user.lifecycle.delete.initiated"}, true},
+		{"duplicate event", []string{"user.lifecycle.create", "user.lifecycle.create"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := idaas.ValidateEventHookFilter(events, tt.filterEvents)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateEventHookFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func eventHookExists(id string) (bool, error) {
 	client := iDaaSAPIClientForTestUtil.OktaSDKClientV2()
 	eh, resp, err := client.EventHook.GetEventHook(context.Background(), id)
@@ -124,12 +243,12 @@ func eventHookExists(id string) (bool, error) {
 	return eh != nil, nil
 }
 
-func testMakeEventHookHeadersSet(headers []*sdk.EventHookChannelConfigHeader) *schema.Set {
+func testMakeEventHookHeadersSet(headers []v6okta.EventHookChannelConfigHeader) *schema.Set {
 	h := make([]interface{}, len(headers))
 	for i, header := range headers {
 		h[i] = map[string]interface{}{
-			"key":   header.Key,
-			"value": header.Value,
+			"key":   header.GetKey(),
+			"value": header.GetValue(),
 		}
 	}
 	return schema.NewSet(schema.HashResource(idaas.HeaderSchema), h)
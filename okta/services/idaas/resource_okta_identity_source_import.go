@@ -2,14 +2,29 @@ package idaas
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	okta "github.com/okta/okta-sdk-golang/v6/okta"
 
@@ -33,6 +48,15 @@ type identitySourceImportModel struct {
 	SessionId        types.String `tfsdk:"session_id"`
 	SessionStatus    types.String `tfsdk:"session_status"`
 
+	BatchSize                types.Int64  `tfsdk:"batch_size"`
+	CheckpointFile           types.String `tfsdk:"checkpoint_file"`
+	WaitForCompletion        types.Bool   `tfsdk:"wait_for_completion"`
+	WaitForCompletionTimeout types.Int64  `tfsdk:"wait_for_completion_timeout"`
+	ChunksTotal              types.Int64  `tfsdk:"chunks_total"`
+	ChunksUploaded           types.Int64  `tfsdk:"chunks_uploaded"`
+
+	SourceFiles []identitySourceImportSourceFileModel `tfsdk:"source_file"`
+
 	UpsertUsers            *identitySourceImportUpsertUsersModel            `tfsdk:"upsert_users"`
 	UpsertGroups           *identitySourceImportUpsertGroupsModel           `tfsdk:"upsert_groups"`
 	DeleteUsers            *identitySourceImportDeleteUsersModel            `tfsdk:"delete_users"`
@@ -59,6 +83,8 @@ type identitySourceImportUpsertUserProfileInner struct {
 	MobilePhone types.String `tfsdk:"mobile_phone"`
 	SecondEmail types.String `tfsdk:"second_email"`
 	UserName    types.String `tfsdk:"user_name"`
+
+	CustomAttributes types.Map `tfsdk:"custom_attributes"`
 }
 
 type identitySourceImportUpsertGroupsModel struct {
@@ -96,6 +122,14 @@ type identitySourceImportDeleteGroupMembershipsModel struct {
 	Memberships []identitySourceImportGroupMembership `tfsdk:"memberships"`
 }
 
+type identitySourceImportSourceFileModel struct {
+	Path       types.String `tfsdk:"path"`
+	Entity     types.String `tfsdk:"entity"`
+	Operation  types.String `tfsdk:"operation"`
+	Format     types.String `tfsdk:"format"`
+	SourceHash types.String `tfsdk:"source_hash"`
+}
+
 type identitySourceImportGroupMembership struct {
 	GroupExternalId   types.String `tfsdk:"group_external_id"`
 	MemberExternalIds types.List   `tfsdk:"member_external_ids"`
@@ -150,11 +184,88 @@ func (r *identitySourceImportResource) Schema(_ context.Context, _ resource.Sche
 				Computed:    true,
 			},
 			"session_status": schema.StringAttribute{
-				Description: "The status of the import session after triggering.",
+				Description: "The status of the import session after triggering, or after completion when `wait_for_completion` is set.",
+				Computed:    true,
+			},
+			"batch_size": schema.Int64Attribute{
+				Description: "Maximum number of records uploaded per request. Larger loads are split into several uploads within the same session. Defaults to and may not exceed `200`, the limit of the bulk upload API.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(identitySourceImportMaxBatchSize),
+				Validators: []validator.Int64{
+					int64validator.Between(1, identitySourceImportMaxBatchSize),
+				},
+			},
+			"checkpoint_file": schema.StringAttribute{
+				Description: "Local file recording which uploads of the session succeeded. When an apply fails part way, the next apply with the same data resumes the session from the last successful upload instead of starting over. The file is removed once the import is triggered.",
+				Optional:    true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Wait for the import session to reach `COMPLETED` after triggering. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"wait_for_completion_timeout": schema.Int64Attribute{
+				Description: "Seconds to wait for the import to complete when `wait_for_completion` is set. Defaults to `3600`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(3600),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"chunks_total": schema.Int64Attribute{
+				Description: "Number of upload requests of the import.",
+				Computed:    true,
+			},
+			"chunks_uploaded": schema.Int64Attribute{
+				Description: "Number of upload requests that succeeded.",
 				Computed:    true,
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"source_file": schema.ListNestedBlock{
+				Description: "CSV or JSONL file of users, groups or group memberships to upload. " +
+					"CSV files have a header row, `externalId` identifies the record and every other column is a profile attribute; " +
+					"membership rows have `groupExternalId` and `memberExternalId` columns. " +
+					"JSONL files have one `{\"externalId\": \"...\", \"profile\": {...}}` or `{\"groupExternalId\": \"...\", \"memberExternalIds\": [...]}` object per line.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Description: "Path of the file.",
+							Required:    true,
+						},
+						"entity": schema.StringAttribute{
+							Description: "What the file contains, one of `USERS`, `GROUPS` or `MEMBERSHIPS`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(identitySourceImportEntityUsers, identitySourceImportEntityGroups, identitySourceImportEntityMemberships),
+							},
+						},
+						"operation": schema.StringAttribute{
+							Description: "Whether the records are upserted or deleted, one of `UPSERT` or `DELETE`. Defaults to `UPSERT`.",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(identitySourceImportOperationUpsert),
+							Validators: []validator.String{
+								stringvalidator.OneOf(identitySourceImportOperationUpsert, identitySourceImportOperationDelete),
+							},
+						},
+						"format": schema.StringAttribute{
+							Description: "Format of the file, `CSV` or `JSONL`. Inferred from the `.csv`, `.jsonl` or `.ndjson` extension when not set.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(identitySourceImportFormatCSV, identitySourceImportFormatJSONL),
+							},
+						},
+						"source_hash": schema.StringAttribute{
+							Description: "Hash of the file content, e.g. `filesha256(path)`. Changing it re-runs the import when the file changes.",
+							Optional:    true,
+						},
+					},
+				},
+			},
 			"upsert_users": schema.SingleNestedBlock{
 				Description: "Users to create or update in Okta.",
 				Attributes: map[string]schema.Attribute{
@@ -184,6 +295,11 @@ func (r *identitySourceImportResource) Schema(_ context.Context, _ resource.Sche
 										"mobile_phone": schema.StringAttribute{Description: "Mobile phone number of the user.", Optional: true},
 										"second_email": schema.StringAttribute{Description: "Alternative email address of the user.", Optional: true},
 										"user_name":    schema.StringAttribute{Description: "Username of the user.", Optional: true},
+										"custom_attributes": schema.MapAttribute{
+											Description: "Additional profile attributes of the user, keyed by attribute name.",
+											ElementType: types.StringType,
+											Optional:    true,
+										},
 									},
 								},
 							},
@@ -274,219 +390,499 @@ func (r *identitySourceImportResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
-// runImport creates a new session, uploads all staged data, and triggers the import.
-// Returns the session ID, session status, and any diagnostics.
-func (r *identitySourceImportResource) runImport(ctx context.Context, plan *identitySourceImportModel) (string, string, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	client := r.Config.OktaIDaaSClient.OktaSDKClientV6()
-	identitySourceId := plan.IdentitySourceId.ValueString()
+// identitySourceImportChunk is a single bulk upload request of an import job.
+type identitySourceImportChunk struct {
+	// kind is the upload block the chunk belongs to, e.g. upsert_users
+	kind    string
+	records int
+	body    interface{}
+}
 
-	// 1. Create session
-	session, _, err := client.IdentitySourceAPI.CreateIdentitySourceSession(ctx, identitySourceId).Execute()
-	if err != nil {
-		diags.AddError("Error creating identity source session", err.Error())
-		return "", "", diags
+// buildImportChunks turns the upload blocks and source files of the plan into
+// the ordered bulk upload requests of the import, split into batches.
+func buildImportChunks(ctx context.Context, plan *identitySourceImportModel) ([]identitySourceImportChunk, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	batchSize := identitySourceImportMaxBatchSize
+	if !plan.BatchSize.IsNull() && !plan.BatchSize.IsUnknown() {
+		batchSize = int(plan.BatchSize.ValueInt64())
 	}
-	sessionId := session.GetId()
 
-	// Defer session cleanup so that panics or early returns after this point don't leave
-	// a stranded session that would block re-apply for 5 minutes.
-	// succeeded is flipped to true only when the import is triggered successfully.
-	succeeded := false
-	defer func() {
-		if !succeeded {
-			_, _ = client.IdentitySourceAPI.DeleteIdentitySourceSession(ctx, identitySourceId, sessionId).Execute()
+	files := map[string][]*IdentitySourceImportFile{}
+	for _, sourceFile := range plan.SourceFiles {
+		operation := sourceFile.Operation.ValueString()
+		if operation == "" {
+			operation = identitySourceImportOperationUpsert
 		}
-	}()
+		file, err := readIdentitySourceImportFile(sourceFile.Path.ValueString(), sourceFile.Format.ValueString(), sourceFile.Entity.ValueString(), operation)
+		if err != nil {
+			diags.AddError("Error reading identity source import file", err.Error())
+			return nil, diags
+		}
+		key := strings.ToLower(operation) + "_" + strings.ToLower(sourceFile.Entity.ValueString())
+		files[key] = append(files[key], file)
+	}
+
+	var chunks []identitySourceImportChunk
 
-	// 2. Upsert users
+	// 1. Upsert users
+	var upsertUsers []okta.BulkUpsertRequestBodyProfilesInner
 	if plan.UpsertUsers != nil {
-		body := okta.NewBulkUpsertRequestBodyWithDefaults()
-		body.SetEntityType(plan.UpsertUsers.EntityType.ValueString())
-		if len(plan.UpsertUsers.Profiles) > 0 {
-			var profiles []okta.BulkUpsertRequestBodyProfilesInner
-			for _, item := range plan.UpsertUsers.Profiles {
-				p := okta.NewBulkUpsertRequestBodyProfilesInnerWithDefaults()
-				if !item.ExternalId.IsNull() && !item.ExternalId.IsUnknown() {
-					p.SetExternalId(item.ExternalId.ValueString())
+		for _, item := range plan.UpsertUsers.Profiles {
+			p := okta.NewBulkUpsertRequestBodyProfilesInnerWithDefaults()
+			if !item.ExternalId.IsNull() && !item.ExternalId.IsUnknown() {
+				p.SetExternalId(item.ExternalId.ValueString())
+			}
+			if item.Profile != nil {
+				up := okta.NewIdentitySourceUserProfileForUpsertWithDefaults()
+				if !item.Profile.Email.IsNull() && !item.Profile.Email.IsUnknown() {
+					up.SetEmail(item.Profile.Email.ValueString())
 				}
-				if item.Profile != nil {
-					up := okta.NewIdentitySourceUserProfileForUpsertWithDefaults()
-					if !item.Profile.Email.IsNull() && !item.Profile.Email.IsUnknown() {
-						up.SetEmail(item.Profile.Email.ValueString())
-					}
-					if !item.Profile.FirstName.IsNull() && !item.Profile.FirstName.IsUnknown() {
-						up.SetFirstName(item.Profile.FirstName.ValueString())
-					}
-					if !item.Profile.HomeAddress.IsNull() && !item.Profile.HomeAddress.IsUnknown() {
-						up.SetHomeAddress(item.Profile.HomeAddress.ValueString())
-					}
-					if !item.Profile.LastName.IsNull() && !item.Profile.LastName.IsUnknown() {
-						up.SetLastName(item.Profile.LastName.ValueString())
-					}
-					if !item.Profile.MobilePhone.IsNull() && !item.Profile.MobilePhone.IsUnknown() {
-						up.SetMobilePhone(item.Profile.MobilePhone.ValueString())
-					}
-					if !item.Profile.SecondEmail.IsNull() && !item.Profile.SecondEmail.IsUnknown() {
-						up.SetSecondEmail(item.Profile.SecondEmail.ValueString())
+				if !item.Profile.FirstName.IsNull() && !item.Profile.FirstName.IsUnknown() {
+					up.SetFirstName(item.Profile.FirstName.ValueString())
+				}
+				if !item.Profile.HomeAddress.IsNull() && !item.Profile.HomeAddress.IsUnknown() {
+					up.SetHomeAddress(item.Profile.HomeAddress.ValueString())
+				}
+				if !item.Profile.LastName.IsNull() && !item.Profile.LastName.IsUnknown() {
+					up.SetLastName(item.Profile.LastName.ValueString())
+				}
+				if !item.Profile.MobilePhone.IsNull() && !item.Profile.MobilePhone.IsUnknown() {
+					up.SetMobilePhone(item.Profile.MobilePhone.ValueString())
+				}
+				if !item.Profile.SecondEmail.IsNull() && !item.Profile.SecondEmail.IsUnknown() {
+					up.SetSecondEmail(item.Profile.SecondEmail.ValueString())
+				}
+				if !item.Profile.UserName.IsNull() && !item.Profile.UserName.IsUnknown() {
+					up.SetUserName(item.Profile.UserName.ValueString())
+				}
+				if !item.Profile.CustomAttributes.IsNull() && !item.Profile.CustomAttributes.IsUnknown() {
+					attributes := map[string]string{}
+					diags.Append(item.Profile.CustomAttributes.ElementsAs(ctx, &attributes, false)...)
+					if diags.HasError() {
+						return nil, diags
 					}
-					if !item.Profile.UserName.IsNull() && !item.Profile.UserName.IsUnknown() {
-						up.SetUserName(item.Profile.UserName.ValueString())
+					up.AdditionalProperties = map[string]interface{}{}
+					for k, v := range attributes {
+						up.AdditionalProperties[k] = v
 					}
-					p.SetProfile(*up)
 				}
-				profiles = append(profiles, *p)
+				p.SetProfile(*up)
 			}
-			body.SetProfiles(profiles)
+			upsertUsers = append(upsertUsers, *p)
+		}
+	}
+	for _, file := range files["upsert_users"] {
+		for _, record := range file.Records {
+			p := okta.NewBulkUpsertRequestBodyProfilesInnerWithDefaults()
+			p.SetExternalId(record.ExternalID)
+			p.SetProfile(okta.IdentitySourceUserProfileForUpsert{AdditionalProperties: record.Profile})
+			upsertUsers = append(upsertUsers, *p)
 		}
-		req := client.IdentitySourceAPI.UploadIdentitySourceDataForUpsert(ctx, identitySourceId, sessionId).BulkUpsertRequestBody(*body)
-		if _, err := req.Execute(); err != nil {
-			diags.AddError("Error uploading upsert users", err.Error())
-			return "", "", diags
+	}
+	if plan.UpsertUsers != nil || len(upsertUsers) > 0 {
+		entityType := identitySourceImportEntityUsers
+		if plan.UpsertUsers != nil {
+			entityType = plan.UpsertUsers.EntityType.ValueString()
+		}
+		for _, batch := range identitySourceImportBatches(upsertUsers, batchSize) {
+			body := okta.NewBulkUpsertRequestBodyWithDefaults()
+			body.SetEntityType(entityType)
+			if len(batch) > 0 {
+				body.SetProfiles(batch)
+			}
+			chunks = append(chunks, identitySourceImportChunk{kind: "upsert_users", records: len(batch), body: *body})
 		}
 	}
 
-	// 3. Upsert groups
+	// 2. Upsert groups
+	var upsertGroups []okta.BulkGroupUpsertRequestBodyProfilesInner
 	if plan.UpsertGroups != nil {
-		body := okta.NewBulkGroupUpsertRequestBody()
-		if len(plan.UpsertGroups.Profiles) > 0 {
-			var profiles []okta.BulkGroupUpsertRequestBodyProfilesInner
-			for _, item := range plan.UpsertGroups.Profiles {
-				p := okta.NewBulkGroupUpsertRequestBodyProfilesInnerWithDefaults()
-				if !item.ExternalId.IsNull() && !item.ExternalId.IsUnknown() {
-					p.SetExternalId(item.ExternalId.ValueString())
+		for _, item := range plan.UpsertGroups.Profiles {
+			p := okta.NewBulkGroupUpsertRequestBodyProfilesInnerWithDefaults()
+			if !item.ExternalId.IsNull() && !item.ExternalId.IsUnknown() {
+				p.SetExternalId(item.ExternalId.ValueString())
+			}
+			if item.GroupProfile != nil {
+				gp := okta.NewIdentitySourceGroupProfileForUpsertWithDefaults()
+				if !item.GroupProfile.Description.IsNull() && !item.GroupProfile.Description.IsUnknown() {
+					gp.SetDescription(item.GroupProfile.Description.ValueString())
 				}
-				if item.GroupProfile != nil {
-					gp := okta.NewIdentitySourceGroupProfileForUpsertWithDefaults()
-					if !item.GroupProfile.Description.IsNull() && !item.GroupProfile.Description.IsUnknown() {
-						gp.SetDescription(item.GroupProfile.Description.ValueString())
-					}
-					if !item.GroupProfile.DisplayName.IsNull() && !item.GroupProfile.DisplayName.IsUnknown() {
-						gp.SetDisplayName(item.GroupProfile.DisplayName.ValueString())
-					}
-					p.SetProfile(*gp)
+				if !item.GroupProfile.DisplayName.IsNull() && !item.GroupProfile.DisplayName.IsUnknown() {
+					gp.SetDisplayName(item.GroupProfile.DisplayName.ValueString())
 				}
-				profiles = append(profiles, *p)
+				p.SetProfile(*gp)
 			}
-			body.SetProfiles(profiles)
+			upsertGroups = append(upsertGroups, *p)
 		}
-		req := client.IdentitySourceAPI.UploadIdentitySourceGroupsForUpsert(ctx, identitySourceId, sessionId).BulkGroupUpsertRequestBody(*body)
-		if _, err := req.Execute(); err != nil {
-			diags.AddError("Error uploading upsert groups", err.Error())
-			return "", "", diags
+	}
+	for _, file := range files["upsert_groups"] {
+		for _, record := range file.Records {
+			p := okta.NewBulkGroupUpsertRequestBodyProfilesInnerWithDefaults()
+			p.SetExternalId(record.ExternalID)
+			p.SetProfile(okta.IdentitySourceGroupProfileForUpsert{AdditionalProperties: record.Profile})
+			upsertGroups = append(upsertGroups, *p)
+		}
+	}
+	if plan.UpsertGroups != nil || len(upsertGroups) > 0 {
+		for _, batch := range identitySourceImportBatches(upsertGroups, batchSize) {
+			body := okta.NewBulkGroupUpsertRequestBody()
+			if len(batch) > 0 {
+				body.SetProfiles(batch)
+			}
+			chunks = append(chunks, identitySourceImportChunk{kind: "upsert_groups", records: len(batch), body: *body})
 		}
 	}
 
-	// 4. Delete users
+	// 3. Delete users
+	var deleteUsers []okta.IdentitySourceUserProfileForDelete
 	if plan.DeleteUsers != nil {
-		body := okta.NewBulkDeleteRequestBodyWithDefaults()
-		body.SetEntityType(plan.DeleteUsers.EntityType.ValueString())
-		if len(plan.DeleteUsers.Profiles) > 0 {
-			var profiles []okta.IdentitySourceUserProfileForDelete
-			for _, item := range plan.DeleteUsers.Profiles {
-				p := okta.NewIdentitySourceUserProfileForDeleteWithDefaults()
-				if !item.ExternalId.IsNull() && !item.ExternalId.IsUnknown() {
-					p.SetExternalId(item.ExternalId.ValueString())
-				}
-				profiles = append(profiles, *p)
+		for _, item := range plan.DeleteUsers.Profiles {
+			p := okta.NewIdentitySourceUserProfileForDeleteWithDefaults()
+			if !item.ExternalId.IsNull() && !item.ExternalId.IsUnknown() {
+				p.SetExternalId(item.ExternalId.ValueString())
 			}
-			body.SetProfiles(profiles)
+			deleteUsers = append(deleteUsers, *p)
+		}
+	}
+	for _, file := range files["delete_users"] {
+		for _, record := range file.Records {
+			p := okta.NewIdentitySourceUserProfileForDeleteWithDefaults()
+			p.SetExternalId(record.ExternalID)
+			deleteUsers = append(deleteUsers, *p)
+		}
+	}
+	if plan.DeleteUsers != nil || len(deleteUsers) > 0 {
+		entityType := identitySourceImportEntityUsers
+		if plan.DeleteUsers != nil {
+			entityType = plan.DeleteUsers.EntityType.ValueString()
 		}
-		req := client.IdentitySourceAPI.UploadIdentitySourceDataForDelete(ctx, identitySourceId, sessionId).BulkDeleteRequestBody(*body)
-		if _, err := req.Execute(); err != nil {
-			diags.AddError("Error uploading delete users", err.Error())
-			return "", "", diags
+		for _, batch := range identitySourceImportBatches(deleteUsers, batchSize) {
+			body := okta.NewBulkDeleteRequestBodyWithDefaults()
+			body.SetEntityType(entityType)
+			if len(batch) > 0 {
+				body.SetProfiles(batch)
+			}
+			chunks = append(chunks, identitySourceImportChunk{kind: "delete_users", records: len(batch), body: *body})
 		}
 	}
 
-	// 5. Delete groups
-	if plan.DeleteGroups != nil {
-		body := okta.NewBulkGroupDeleteRequestBodyWithDefaults()
-		if !plan.DeleteGroups.ExternalIds.IsNull() && !plan.DeleteGroups.ExternalIds.IsUnknown() {
-			var ids []string
-			for _, elem := range plan.DeleteGroups.ExternalIds.Elements() {
-				if sv, ok := elem.(types.String); ok {
-					ids = append(ids, sv.ValueString())
-				}
+	// 4. Delete groups
+	var deleteGroups []string
+	if plan.DeleteGroups != nil && !plan.DeleteGroups.ExternalIds.IsNull() && !plan.DeleteGroups.ExternalIds.IsUnknown() {
+		for _, elem := range plan.DeleteGroups.ExternalIds.Elements() {
+			if sv, ok := elem.(types.String); ok {
+				deleteGroups = append(deleteGroups, sv.ValueString())
 			}
-			body.SetExternalIds(ids)
 		}
-		req := client.IdentitySourceAPI.UploadIdentitySourceGroupsDataForDelete(ctx, identitySourceId, sessionId).BulkGroupDeleteRequestBody(*body)
-		if _, err := req.Execute(); err != nil {
-			diags.AddError("Error uploading delete groups", err.Error())
-			return "", "", diags
+	}
+	for _, file := range files["delete_groups"] {
+		for _, record := range file.Records {
+			deleteGroups = append(deleteGroups, record.ExternalID)
+		}
+	}
+	if plan.DeleteGroups != nil || len(deleteGroups) > 0 {
+		for _, batch := range identitySourceImportBatches(deleteGroups, batchSize) {
+			body := okta.NewBulkGroupDeleteRequestBodyWithDefaults()
+			if len(batch) > 0 {
+				body.SetExternalIds(batch)
+			}
+			chunks = append(chunks, identitySourceImportChunk{kind: "delete_groups", records: len(batch), body: *body})
 		}
 	}
 
-	// 6. Upsert group memberships
+	// 5. Upsert group memberships
+	var upsertMemberships []IdentitySourceImportMembership
 	if plan.UpsertGroupMemberships != nil {
-		body := okta.NewBulkGroupMembershipsUpsertRequestBodyWithDefaults()
-		if len(plan.UpsertGroupMemberships.Memberships) > 0 {
+		upsertMemberships = identitySourceImportMemberships(plan.UpsertGroupMemberships.Memberships)
+	}
+	for _, file := range files["upsert_memberships"] {
+		upsertMemberships = append(upsertMemberships, file.Memberships...)
+	}
+	if plan.UpsertGroupMemberships != nil || len(upsertMemberships) > 0 {
+		for _, batch := range IdentitySourceImportMembershipBatches(upsertMemberships, batchSize) {
+			body := okta.NewBulkGroupMembershipsUpsertRequestBodyWithDefaults()
 			var memberships []okta.IdentitySourceGroupMembershipsUpsertProfileInner
-			for _, item := range plan.UpsertGroupMemberships.Memberships {
+			records := 0
+			for _, membership := range batch {
 				m := okta.NewIdentitySourceGroupMembershipsUpsertProfileInnerWithDefaults()
-				if !item.GroupExternalId.IsNull() && !item.GroupExternalId.IsUnknown() {
-					m.SetGroupExternalId(item.GroupExternalId.ValueString())
+				if membership.GroupExternalID != "" {
+					m.SetGroupExternalId(membership.GroupExternalID)
 				}
-				if !item.MemberExternalIds.IsNull() && !item.MemberExternalIds.IsUnknown() {
-					var ids []string
-					for _, elem := range item.MemberExternalIds.Elements() {
-						if sv, ok := elem.(types.String); ok {
-							ids = append(ids, sv.ValueString())
-						}
-					}
-					m.SetMemberExternalIds(ids)
+				if membership.MemberExternalIDs != nil {
+					m.SetMemberExternalIds(membership.MemberExternalIDs)
 				}
 				memberships = append(memberships, *m)
+				records += len(membership.MemberExternalIDs)
 			}
-			body.SetMemberships(memberships)
-		}
-		req := client.IdentitySourceAPI.UploadIdentitySourceGroupMembershipsForUpsert(ctx, identitySourceId, sessionId).BulkGroupMembershipsUpsertRequestBody(*body)
-		if _, err := req.Execute(); err != nil {
-			diags.AddError("Error uploading upsert group memberships", err.Error())
-			return "", "", diags
+			if len(memberships) > 0 {
+				body.SetMemberships(memberships)
+			}
+			chunks = append(chunks, identitySourceImportChunk{kind: "upsert_group_memberships", records: records, body: *body})
 		}
 	}
 
-	// 7. Delete group memberships
+	// 6. Delete group memberships
+	var deleteMemberships []IdentitySourceImportMembership
 	if plan.DeleteGroupMemberships != nil {
-		body := okta.NewBulkGroupMembershipsDeleteRequestBodyWithDefaults()
-		if len(plan.DeleteGroupMemberships.Memberships) > 0 {
+		deleteMemberships = identitySourceImportMemberships(plan.DeleteGroupMemberships.Memberships)
+	}
+	for _, file := range files["delete_memberships"] {
+		deleteMemberships = append(deleteMemberships, file.Memberships...)
+	}
+	if plan.DeleteGroupMemberships != nil || len(deleteMemberships) > 0 {
+		for _, batch := range IdentitySourceImportMembershipBatches(deleteMemberships, batchSize) {
+			body := okta.NewBulkGroupMembershipsDeleteRequestBodyWithDefaults()
 			var memberships []okta.IdentitySourceGroupMembershipsDeleteProfileInner
-			for _, item := range plan.DeleteGroupMemberships.Memberships {
+			records := 0
+			for _, membership := range batch {
 				m := okta.NewIdentitySourceGroupMembershipsDeleteProfileInnerWithDefaults()
-				if !item.GroupExternalId.IsNull() && !item.GroupExternalId.IsUnknown() {
-					m.SetGroupExternalId(item.GroupExternalId.ValueString())
+				if membership.GroupExternalID != "" {
+					m.SetGroupExternalId(membership.GroupExternalID)
 				}
-				if !item.MemberExternalIds.IsNull() && !item.MemberExternalIds.IsUnknown() {
-					var ids []string
-					for _, elem := range item.MemberExternalIds.Elements() {
-						if sv, ok := elem.(types.String); ok {
-							ids = append(ids, sv.ValueString())
-						}
-					}
-					m.SetMemberExternalIds(ids)
+				if membership.MemberExternalIDs != nil {
+					m.SetMemberExternalIds(membership.MemberExternalIDs)
 				}
 				memberships = append(memberships, *m)
+				records += len(membership.MemberExternalIDs)
+			}
+			if len(memberships) > 0 {
+				body.SetMemberships(memberships)
+			}
+			chunks = append(chunks, identitySourceImportChunk{kind: "delete_group_memberships", records: records, body: *body})
+		}
+	}
+
+	return chunks, diags
+}
+
+// identitySourceImportBatches splits records into batches of at most size
+// records. A block without records still results in a single, empty upload.
+func identitySourceImportBatches[T any](records []T, size int) [][]T {
+	if len(records) == 0 {
+		return [][]T{nil}
+	}
+	var batches [][]T
+	for batch := range slices.Chunk(records, size) {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// IdentitySourceImportMembershipBatches splits memberships into upload batches
+// of at most size member IDs.
+func IdentitySourceImportMembershipBatches(memberships []IdentitySourceImportMembership, size int) [][]IdentitySourceImportMembership {
+	if len(memberships) == 0 {
+		return [][]IdentitySourceImportMembership{nil}
+	}
+	// memberships without members are sent in batches of their own so the
+	// member IDs of the others still honor the batch size
+	var withMembers, withoutMembers []IdentitySourceImportMembership
+	for _, membership := range memberships {
+		if len(membership.MemberExternalIDs) == 0 {
+			withoutMembers = append(withoutMembers, membership)
+		} else {
+			withMembers = append(withMembers, membership)
+		}
+	}
+	batches := ChunkIdentitySourceImportMemberships(withMembers, size)
+	for i := 0; i < len(withoutMembers); i += size {
+		batches = append(batches, withoutMembers[i:min(i+size, len(withoutMembers))])
+	}
+	return batches
+}
+
+func identitySourceImportMemberships(items []identitySourceImportGroupMembership) []IdentitySourceImportMembership {
+	var memberships []IdentitySourceImportMembership
+	for _, item := range items {
+		var membership IdentitySourceImportMembership
+		if !item.GroupExternalId.IsNull() && !item.GroupExternalId.IsUnknown() {
+			membership.GroupExternalID = item.GroupExternalId.ValueString()
+		}
+		if !item.MemberExternalIds.IsNull() && !item.MemberExternalIds.IsUnknown() {
+			membership.MemberExternalIDs = []string{}
+			for _, elem := range item.MemberExternalIds.Elements() {
+				if sv, ok := elem.(types.String); ok {
+					membership.MemberExternalIDs = append(membership.MemberExternalIDs, sv.ValueString())
+				}
+			}
+		}
+		memberships = append(memberships, membership)
+	}
+	return memberships
+}
+
+func uploadImportChunk(ctx context.Context, client *okta.APIClient, identitySourceId, sessionId string, chunk identitySourceImportChunk) error {
+	var err error
+	switch body := chunk.body.(type) {
+	case okta.BulkUpsertRequestBody:
+		_, err = client.IdentitySourceAPI.UploadIdentitySourceDataForUpsert(ctx, identitySourceId, sessionId).BulkUpsertRequestBody(body).Execute()
+	case okta.BulkGroupUpsertRequestBody:
+		_, err = client.IdentitySourceAPI.UploadIdentitySourceGroupsForUpsert(ctx, identitySourceId, sessionId).BulkGroupUpsertRequestBody(body).Execute()
+	case okta.BulkDeleteRequestBody:
+		_, err = client.IdentitySourceAPI.UploadIdentitySourceDataForDelete(ctx, identitySourceId, sessionId).BulkDeleteRequestBody(body).Execute()
+	case okta.BulkGroupDeleteRequestBody:
+		_, err = client.IdentitySourceAPI.UploadIdentitySourceGroupsDataForDelete(ctx, identitySourceId, sessionId).BulkGroupDeleteRequestBody(body).Execute()
+	case okta.BulkGroupMembershipsUpsertRequestBody:
+		_, err = client.IdentitySourceAPI.UploadIdentitySourceGroupMembershipsForUpsert(ctx, identitySourceId, sessionId).BulkGroupMembershipsUpsertRequestBody(body).Execute()
+	case okta.BulkGroupMembershipsDeleteRequestBody:
+		_, err = client.IdentitySourceAPI.UploadIdentitySourceGroupMembershipsForDelete(ctx, identitySourceId, sessionId).BulkGroupMembershipsDeleteRequestBody(body).Execute()
+	default:
+		err = fmt.Errorf("unsupported upload %T", body)
+	}
+	return err
+}
+
+// runImport creates a new session, or resumes the one of the checkpoint file,
+// uploads all staged data in batches, and triggers the import.
+func (r *identitySourceImportResource) runImport(ctx context.Context, plan *identitySourceImportModel) diag.Diagnostics {
+	client := r.Config.OktaIDaaSClient.OktaSDKClientV6()
+	identitySourceId := plan.IdentitySourceId.ValueString()
+
+	chunks, diags := buildImportChunks(ctx, plan)
+	if diags.HasError() {
+		return diags
+	}
+	plan.ChunksTotal = types.Int64Value(int64(len(chunks)))
+	plan.ChunksUploaded = types.Int64Value(0)
+
+	var checkpoint *identitySourceImportCheckpoint
+	checkpointFile := plan.CheckpointFile.ValueString()
+	if checkpointFile != "" {
+		fingerprint, err := identitySourceImportFingerprint(identitySourceId, chunks)
+		if err != nil {
+			diags.AddError("Error fingerprinting identity source import", err.Error())
+			return diags
+		}
+		previous, err := readIdentitySourceImportCheckpoint(checkpointFile)
+		if err != nil {
+			diags.AddError("Error reading identity source import checkpoint", err.Error())
+			return diags
+		}
+		if previous != nil && previous.IdentitySourceID == identitySourceId && previous.Fingerprint == fingerprint {
+			// only a session that has not been triggered yet can take more data
+			session, _, err := client.IdentitySourceAPI.GetIdentitySourceSession(ctx, identitySourceId, previous.SessionID).Execute()
+			if err == nil && session.GetStatus() == "CREATED" {
+				tflog.Info(ctx, "resuming identity source import", map[string]interface{}{
+					"session_id":      previous.SessionID,
+					"chunks_uploaded": previous.ChunksUploaded,
+					"chunks_total":    previous.ChunksTotal,
+				})
+				checkpoint = previous
 			}
-			body.SetMemberships(memberships)
 		}
-		req := client.IdentitySourceAPI.UploadIdentitySourceGroupMembershipsForDelete(ctx, identitySourceId, sessionId).BulkGroupMembershipsDeleteRequestBody(*body)
-		if _, err := req.Execute(); err != nil {
-			diags.AddError("Error uploading delete group memberships", err.Error())
-			return "", "", diags
+		if checkpoint == nil {
+			checkpoint = &identitySourceImportCheckpoint{
+				IdentitySourceID: identitySourceId,
+				Fingerprint:      fingerprint,
+				ChunksTotal:      len(chunks),
+			}
+		}
+	}
+
+	// 1. Create session
+	var sessionId string
+	if checkpoint != nil && checkpoint.SessionID != "" {
+		sessionId = checkpoint.SessionID
+	} else {
+		session, _, err := client.IdentitySourceAPI.CreateIdentitySourceSession(ctx, identitySourceId).Execute()
+		if err != nil {
+			diags.AddError("Error creating identity source session", err.Error())
+			return diags
+		}
+		sessionId = session.GetId()
+	}
+	plan.SessionId = types.StringValue(sessionId)
+
+	// Defer session cleanup so that panics or early returns after this point don't leave
+	// a stranded session that would block re-apply for 5 minutes.
+	// succeeded is flipped to true only when the import is triggered successfully.
+	// With a checkpoint file the session is kept so the next apply resumes it.
+	succeeded := false
+	defer func() {
+		if !succeeded && checkpoint == nil {
+			_, _ = client.IdentitySourceAPI.DeleteIdentitySourceSession(ctx, identitySourceId, sessionId).Execute()
+		}
+	}()
+
+	// 2. Upload the chunks
+	start := 0
+	if checkpoint != nil {
+		checkpoint.SessionID = sessionId
+		start = checkpoint.ChunksUploaded
+	}
+	for i := start; i < len(chunks); i++ {
+		chunk := chunks[i]
+		if err := uploadImportChunk(ctx, client, identitySourceId, sessionId, chunk); err != nil {
+			diags.AddError(
+				"Error uploading "+strings.ReplaceAll(chunk.kind, "_", " "),
+				fmt.Sprintf("chunk %d of %d: %s", i+1, len(chunks), err.Error()),
+			)
+			return diags
+		}
+		plan.ChunksUploaded = types.Int64Value(int64(i + 1))
+		tflog.Debug(ctx, "uploaded identity source import chunk", map[string]interface{}{
+			"session_id": sessionId,
+			"kind":       chunk.kind,
+			"chunk":      i + 1,
+			"chunks":     len(chunks),
+			"records":    chunk.records,
+		})
+		if checkpoint != nil {
+			checkpoint.ChunksUploaded = i + 1
+			if err := writeIdentitySourceImportCheckpoint(checkpointFile, checkpoint); err != nil {
+				diags.AddError("Error writing identity source import checkpoint", err.Error())
+				return diags
+			}
 		}
 	}
+	plan.ChunksUploaded = types.Int64Value(int64(len(chunks)))
 
-	// 8. Trigger import
+	// 3. Trigger import
 	result, _, err := client.IdentitySourceAPI.StartImportFromIdentitySource(ctx, identitySourceId, sessionId).Execute()
 	if err != nil {
 		diags.AddError("Error triggering identity source import", err.Error())
-		return "", "", diags
+		return diags
 	}
-
 	succeeded = true
-	return sessionId, result.GetStatus(), diags
+	plan.SessionStatus = types.StringValue(result.GetStatus())
+	if checkpoint != nil {
+		if err := os.Remove(checkpointFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+			diags.AddWarning("Error removing identity source import checkpoint", err.Error())
+		}
+	}
+
+	// 4. Wait for the import to complete
+	if plan.WaitForCompletion.ValueBool() {
+		timeout := time.Duration(plan.WaitForCompletionTimeout.ValueInt64()) * time.Second
+		status, err := r.waitForImport(ctx, identitySourceId, sessionId, timeout)
+		plan.SessionStatus = types.StringValue(status)
+		if err != nil {
+			diags.AddError("Error waiting for identity source import to complete", err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (r *identitySourceImportResource) waitForImport(ctx context.Context, identitySourceId, sessionId string, timeout time.Duration) (string, error) {
+	client := r.Config.OktaIDaaSClient.OktaSDKClientV6()
+	var status string
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		session, _, err := client.IdentitySourceAPI.GetIdentitySourceSession(ctx, identitySourceId, sessionId).Execute()
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		status = session.GetStatus()
+		switch status {
+		case "COMPLETED":
+			return nil
+		case "ERROR", "EXPIRED", "CLOSED":
+			return retry.NonRetryableError(fmt.Errorf("import session %s ended with status %s", sessionId, status))
+		}
+		return retry.RetryableError(fmt.Errorf("import session %s is %s", sessionId, status))
+	})
+	return status, err
 }
 
 func (r *identitySourceImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -496,15 +892,12 @@ func (r *identitySourceImportResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	sessionId, status, diags := r.runImport(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.runImport(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	plan.ID = types.StringValue(sessionId)
-	plan.SessionId = types.StringValue(sessionId)
-	plan.SessionStatus = types.StringValue(status)
+	plan.ID = plan.SessionId
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -548,15 +941,12 @@ func (r *identitySourceImportResource) Update(ctx context.Context, req resource.
 		return
 	}
 
-	sessionId, status, diags := r.runImport(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(r.runImport(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	plan.ID = state.ID
-	plan.SessionId = types.StringValue(sessionId)
-	plan.SessionStatus = types.StringValue(status)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -2,12 +2,15 @@ package idaas_test
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/okta/terraform-provider-okta/okta/acctest"
 	"github.com/okta/terraform-provider-okta/okta/resources"
+	"github.com/okta/terraform-provider-okta/okta/services/idaas"
 )
 
 // TestAccResourceOktaIdentitySourceImport_uploadError verifies that when an
@@ -56,3 +59,178 @@ func TestAccResourceOktaIdentitySourceImport_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestParseIdentitySourceImportFile(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		format    string
+		entity    string
+		operation string
+		expected  *idaas.IdentitySourceImportFile
+		err       string
+	}{
+		{
+			name:      "csv users with custom attributes",
+			input:     "\ufeffexternalId,userName,email,costCenter\nU1,u1@example.com,u1@example.com,42\nU2,u2@example.com,,\n",
+			format:    "CSV",
+			entity:    "USERS",
+			operation: "UPSERT",
+			expected: &idaas.IdentitySourceImportFile{
+				Records: []idaas.IdentitySourceImportRecord{
+					{ExternalID: "U1", Profile: map[string]interface{}{"userName": "u1@example.com", "email": "u1@example.com", "costCenter": "42"}},
+					{ExternalID: "U2", Profile: map[string]interface{}{"userName": "u2@example.com"}},
+				},
+			},
+		},
+		{
+			name:      "csv users delete",
+			input:     "externalId,userName\nU1,u1@example.com\n",
+			format:    "CSV",
+			entity:    "USERS",
+			operation: "DELETE",
+			expected: &idaas.IdentitySourceImportFile{
+				Records: []idaas.IdentitySourceImportRecord{{ExternalID: "U1"}},
+			},
+		},
+		{
+			name:      "csv memberships are merged per group",
+			input:     "groupExternalId,memberExternalId\nG1,U1\nG2,U3\nG1,U2\n",
+			format:    "CSV",
+			entity:    "MEMBERSHIPS",
+			operation: "UPSERT",
+			expected: &idaas.IdentitySourceImportFile{
+				Memberships: []idaas.IdentitySourceImportMembership{
+					{GroupExternalID: "G1", MemberExternalIDs: []string{"U1", "U2"}},
+					{GroupExternalID: "G2", MemberExternalIDs: []string{"U3"}},
+				},
+			},
+		},
+		{
+			name:      "jsonl groups",
+			input:     `{"externalId": "G1", "profile": {"displayName": "Group 1", "region": "EU"}}` + "\n\n" + `{"externalId": "G2", "profile": {"displayName": "Group 2"}}`,
+			format:    "JSONL",
+			entity:    "GROUPS",
+			operation: "UPSERT",
+			expected: &idaas.IdentitySourceImportFile{
+				Records: []idaas.IdentitySourceImportRecord{
+					{ExternalID: "G1", Profile: map[string]interface{}{"displayName": "Group 1", "region": "EU"}},
+					{ExternalID: "G2", Profile: map[string]interface{}{"displayName": "Group 2"}},
+				},
+			},
+		},
+		{
+			name:      "jsonl memberships",
+			input:     `{"groupExternalId": "G1", "memberExternalIds": ["U1", "U2"]}`,
+			format:    "JSONL",
+			entity:    "MEMBERSHIPS",
+			operation: "DELETE",
+			expected: &idaas.IdentitySourceImportFile{
+				Memberships: []idaas.IdentitySourceImportMembership{
+					{GroupExternalID: "G1", MemberExternalIDs: []string{"U1", "U2"}},
+				},
+			},
+		},
+		{
+			name:      "missing external id",
+			input:     "userName\nu1@example.com\n",
+			format:    "CSV",
+			entity:    "USERS",
+			operation: "UPSERT",
+			err:       "record 1: missing externalId",
+		},
+		{
+			name:      "missing member",
+			input:     `{"groupExternalId": "G1"}`,
+			format:    "JSONL",
+			entity:    "MEMBERSHIPS",
+			operation: "UPSERT",
+			err:       "record 1: missing memberExternalId",
+		},
+		{
+			name:      "invalid json",
+			input:     `{"externalId": "U1"` + "\n",
+			format:    "JSONL",
+			entity:    "USERS",
+			operation: "UPSERT",
+			err:       "line 1:",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			file, err := idaas.ParseIdentitySourceImportFile(strings.NewReader(tc.input), tc.format, tc.entity, tc.operation)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(file, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, file)
+			}
+		})
+	}
+}
+
+func TestIdentitySourceImportFileFormat(t *testing.T) {
+	tests := []struct {
+		path, format, expected string
+		err                    bool
+	}{
+		{path: "users.csv", expected: "CSV"},
+		{path: "users.CSV", expected: "CSV"},
+		{path: "users.jsonl", expected: "JSONL"},
+		{path: "users.ndjson", expected: "JSONL"},
+		{path: "users.txt", format: "csv", expected: "CSV"},
+		{path: "users.txt", err: true},
+	}
+	for _, tc := range tests {
+		format, err := idaas.IdentitySourceImportFileFormat(tc.path, tc.format)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", tc.path)
+			}
+			continue
+		}
+		if err != nil || format != tc.expected {
+			t.Errorf("%s: expected %s, got %s (%v)", tc.path, tc.expected, format, err)
+		}
+	}
+}
+
+func TestChunkIdentitySourceImportMemberships(t *testing.T) {
+	memberships := []idaas.IdentitySourceImportMembership{
+		{GroupExternalID: "G1", MemberExternalIDs: []string{"U1", "U2", "U3"}},
+		{GroupExternalID: "G2", MemberExternalIDs: []string{"U4"}},
+		{GroupExternalID: "G3", MemberExternalIDs: []string{"U5", "U6"}},
+	}
+	expected := [][]idaas.IdentitySourceImportMembership{
+		{{GroupExternalID: "G1", MemberExternalIDs: []string{"U1", "U2"}}},
+		{{GroupExternalID: "G1", MemberExternalIDs: []string{"U3"}}, {GroupExternalID: "G2", MemberExternalIDs: []string{"U4"}}},
+		{{GroupExternalID: "G3", MemberExternalIDs: []string{"U5", "U6"}}},
+	}
+	chunks := idaas.ChunkIdentitySourceImportMemberships(memberships, 2)
+	if !reflect.DeepEqual(chunks, expected) {
+		t.Errorf("expected %+v, got %+v", expected, chunks)
+	}
+}
+
+func TestIdentitySourceImportMembershipBatches(t *testing.T) {
+	memberships := []idaas.IdentitySourceImportMembership{
+		{GroupExternalID: "G1", MemberExternalIDs: []string{"U1", "U2", "U3"}},
+		{GroupExternalID: "G2"},
+		{GroupExternalID: "G3", MemberExternalIDs: []string{"U4"}},
+	}
+	expected := [][]idaas.IdentitySourceImportMembership{
+		{{GroupExternalID: "G1", MemberExternalIDs: []string{"U1", "U2"}}},
+		{{GroupExternalID: "G1", MemberExternalIDs: []string{"U3"}}, {GroupExternalID: "G3", MemberExternalIDs: []string{"U4"}}},
+		{{GroupExternalID: "G2"}},
+	}
+	batches := idaas.IdentitySourceImportMembershipBatches(memberships, 2)
+	if !reflect.DeepEqual(batches, expected) {
+		t.Errorf("expected %+v, got %+v", expected, batches)
+	}
+}
@@ -3,12 +3,15 @@ package idaas
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"reflect"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v5okta "github.com/okta/okta-sdk-golang/v5/okta"
 	"github.com/okta/terraform-provider-okta/okta/utils"
-	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 var HeaderSchema = &schema.Resource{
@@ -24,6 +27,173 @@ var HeaderSchema = &schema.Resource{
 	},
 }
 
+const (
+	hookOAuth2ChannelType      = "OAUTH"
+	hookOAuth2ClientSecretPost = "client_secret_post"
+	hookOAuth2PrivateKeyJWT    = "private_key_jwt"
+)
+
+// hookOAuth2Schema is the typed OAuth 2.0 configuration of an inline or event
+// hook channel, Okta fetches an access token from token_url before calling the
+// hook endpoint.
+var hookOAuth2Schema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"auth_type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{hookOAuth2ClientSecretPost, hookOAuth2PrivateKeyJWT}, false),
+			Description:  "The client authentication method used at the token endpoint: `client_secret_post` or `private_key_jwt`.",
+		},
+		"client_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The client ID Okta authenticates as.",
+		},
+		"token_url": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The token endpoint Okta requests the access token from.",
+		},
+		"scope": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Space separated scopes requested for the access token.",
+		},
+		"hook_key_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "ID of the `okta_hook_key` whose private key signs the client assertion. Required with `private_key_jwt`.",
+		},
+		"client_secret_wo": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Description: "Write-only client secret for Terraform 1.11+. Required with `client_secret_post`. Okta never returns the secret, so it is neither stored in state nor compared on refresh.",
+		},
+		"client_secret_wo_version": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Version number for the write-only client secret. Increment this value to trigger an update when changing `client_secret_wo`.",
+		},
+	},
+}
+
+// hookOAuth2 is the OAuth 2.0 part of a hook channel config.
+type hookOAuth2 struct {
+	AuthType     string
+	ClientId     string
+	ClientSecret string
+	TokenUrl     string
+	Scope        string
+	HookKeyId    string
+}
+
+// buildHookOAuth2 reads the channel_oauth2 block, the client secret comes
+// from the raw config as it is write-only.
+func buildHookOAuth2(d *schema.ResourceData) (*hookOAuth2, error) {
+	raw, ok := d.GetOk("channel_oauth2")
+	if !ok || len(raw.([]interface{})) == 0 || raw.([]interface{})[0] == nil {
+		return nil, nil
+	}
+	m := raw.([]interface{})[0].(map[string]interface{})
+	oauth2 := &hookOAuth2{
+		AuthType:  m["auth_type"].(string),
+		ClientId:  m["client_id"].(string),
+		TokenUrl:  m["token_url"].(string),
+		Scope:     m["scope"].(string),
+		HookKeyId: m["hook_key_id"].(string),
+	}
+	woVal, _ := d.GetRawConfigAt(cty.GetAttrPath("channel_oauth2").IndexInt(0).GetAttr("client_secret_wo"))
+	if woVal.IsKnown() && !woVal.IsNull() {
+		oauth2.ClientSecret = woVal.AsString()
+	}
+	if err := ValidateHookOAuth2(oauth2.AuthType, oauth2.HookKeyId, oauth2.ClientSecret != ""); err != nil {
+		return nil, err
+	}
+	return oauth2, nil
+}
+
+// hookOAuth2Properties are the OAuth 2.0 fields of a hook channel config. The
+// SDK channel config models don't have them, they are sent as additional
+// properties.
+func hookOAuth2Properties(oauth2 *hookOAuth2) map[string]interface{} {
+	properties := map[string]interface{}{}
+	for key, value := range map[string]string{
+		"authType":     oauth2.AuthType,
+		"clientId":     oauth2.ClientId,
+		"clientSecret": oauth2.ClientSecret,
+		"tokenUrl":     oauth2.TokenUrl,
+		"scope":        oauth2.Scope,
+		"hookKeyId":    oauth2.HookKeyId,
+	} {
+		if value != "" {
+			properties[key] = value
+		}
+	}
+	return properties
+}
+
+// hookOAuth2FromProperties reads the OAuth 2.0 fields from the additional
+// properties of a hook channel config.
+func hookOAuth2FromProperties(properties map[string]interface{}) hookOAuth2 {
+	property := func(key string) string {
+		value, _ := properties[key].(string)
+		return value
+	}
+	return hookOAuth2{
+		AuthType:  property("authType"),
+		ClientId:  property("clientId"),
+		TokenUrl:  property("tokenUrl"),
+		Scope:     property("scope"),
+		HookKeyId: property("hookKeyId"),
+	}
+}
+
+// ValidateHookOAuth2 checks that the credential matching the client
+// authentication method is set, and only that one.
+func ValidateHookOAuth2(authType, hookKeyID string, hasClientSecret bool) error {
+	switch authType {
+	case hookOAuth2ClientSecretPost:
+		if !hasClientSecret {
+			return errors.New("channel_oauth2.client_secret_wo is required when auth_type is client_secret_post")
+		}
+		if hookKeyID != "" {
+			return errors.New("channel_oauth2.hook_key_id can only be set when auth_type is private_key_jwt")
+		}
+	case hookOAuth2PrivateKeyJWT:
+		if hookKeyID == "" {
+			return errors.New("channel_oauth2.hook_key_id is required when auth_type is private_key_jwt")
+		}
+		if hasClientSecret {
+			return errors.New("channel_oauth2.client_secret_wo can only be set when auth_type is client_secret_post")
+		}
+	}
+	return nil
+}
+
+// flattenHookOAuth2 sets the channel_oauth2 block from the API response. Okta
+// doesn't return the client secret, the secret version is kept from state.
+func flattenHookOAuth2(d *schema.ResourceData, channelType string, oauth2 hookOAuth2) []interface{} {
+	if channelType != hookOAuth2ChannelType {
+		return nil
+	}
+	var secretVersion interface{}
+	if raw, ok := d.GetOk("channel_oauth2"); ok && len(raw.([]interface{})) > 0 && raw.([]interface{})[0] != nil {
+		secretVersion = raw.([]interface{})[0].(map[string]interface{})["client_secret_wo_version"]
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"auth_type":                oauth2.AuthType,
+			"client_id":                oauth2.ClientId,
+			"token_url":                oauth2.TokenUrl,
+			"scope":                    oauth2.Scope,
+			"hook_key_id":              oauth2.HookKeyId,
+			"client_secret_wo_version": secretVersion,
+		},
+	}
+}
+
 func resourceInlineHook() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceInlineHookCreate,
@@ -73,7 +243,7 @@ func resourceInlineHook() *schema.Resource {
 					}
 					return false
 				},
-				ConflictsWith: []string{"channel_json"},
+				ConflictsWith: []string{"channel_json", "channel_oauth2"},
 			},
 			"channel": {
 				Type:     schema.TypeMap,
@@ -99,20 +269,32 @@ func resourceInlineHook() *schema.Resource {
 				ValidateDiagFunc: stringIsJSON,
 				StateFunc:        utils.NormalizeDataJSON,
 				DiffSuppressFunc: noChangeInObjectFromUnmarshaledChannelJSON,
-				ConflictsWith:    []string{"channel", "auth"},
+				ConflictsWith:    []string{"channel", "auth", "channel_oauth2"},
+			},
+			"channel_oauth2": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Elem:          hookOAuth2Schema,
+				ConflictsWith: []string{"channel_json", "auth"},
+				Description:   "OAuth 2.0 authentication of the `channel`, the channel type becomes `OAUTH`.",
 			},
 		},
 	}
 }
 
 func resourceInlineHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	hook := buildInlineHook(d)
-	newHook, _, err := getOktaClientFromMetadata(meta).InlineHook.CreateInlineHook(ctx, hook)
+	client := getOktaV5ClientFromMetadata(meta)
+	hook, err := buildInlineHook(d)
+	if err != nil {
+		return diag.Errorf("failed to create inline hook: %v", err)
+	}
+	newHook, _, err := client.InlineHookAPI.CreateInlineHook(ctx).InlineHook(*hook).Execute()
 	if err != nil {
 		return diag.Errorf("failed to create inline hook: %v", err)
 	}
-	d.SetId(newHook.Id)
-	err = setInlineHookStatus(ctx, d, getOktaClientFromMetadata(meta), newHook.Status)
+	d.SetId(newHook.GetId())
+	err = setInlineHookStatus(ctx, d, client, newHook.GetStatus())
 	if err != nil {
 		return diag.Errorf("failed to change inline hook's status: %v", err)
 	}
@@ -120,46 +302,53 @@ func resourceInlineHookCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceInlineHookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	hook, resp, err := getOktaClientFromMetadata(meta).InlineHook.GetInlineHook(ctx, d.Id())
-	if err := utils.SuppressErrorOn404(resp, err); err != nil {
+	hook, resp, err := getOktaV5ClientFromMetadata(meta).InlineHookAPI.GetInlineHook(ctx, d.Id()).Execute()
+	if err := utils.SuppressErrorOn404_V5(resp, err); err != nil {
 		return diag.Errorf("failed to get inline hook: %v", err)
 	}
 	if hook == nil {
 		d.SetId("")
 		return nil
 	}
-	_ = d.Set("name", hook.Name)
-	_ = d.Set("status", hook.Status)
-	_ = d.Set("type", hook.Type)
-	_ = d.Set("version", hook.Version)
+	_ = d.Set("name", hook.GetName())
+	_ = d.Set("status", hook.GetStatus())
+	_ = d.Set("type", hook.GetType())
+	_ = d.Set("version", hook.GetVersion())
 
+	channel := hook.GetChannel()
+	config, err := inlineHookChannelConfig(channel)
+	if err != nil {
+		return diag.Errorf("failed to read inline hook channel config: %v", err)
+	}
 	if oldChannelJson, ok := d.GetOk("channel_json"); ok {
 		// NOTE: Okta responses don't include config.clientSecret so copy the
 		// secret over if it exists the existing channel json
-		var oldChannel sdk.InlineHookChannel
+		var oldChannel v5okta.InlineHookChannel
 		if err = json.Unmarshal([]byte(oldChannelJson.(string)), &oldChannel); err == nil {
-			if oldChannel.Config != nil && oldChannel.Config.ClientSecret != "" {
-				if hook.Channel != nil && hook.Channel.Config != nil {
-					hook.Channel.Config.ClientSecret = oldChannel.Config.ClientSecret
+			if oldConfig, err := inlineHookChannelConfig(oldChannel); err == nil {
+				if clientSecret, ok := oldConfig.AdditionalProperties["clientSecret"]; ok && clientSecret != "" {
+					config.AdditionalProperties["clientSecret"] = clientSecret
 				}
-			}
-			if oldChannel.Config != nil && oldChannel.Config.AuthScheme != nil {
-				if hook.Channel != nil && hook.Channel.Config != nil {
-					hook.Channel.Config.AuthScheme.Value = oldChannel.Config.AuthScheme.Value
+				if oldConfig.AuthScheme != nil && config.AuthScheme != nil {
+					config.AuthScheme.Value = oldConfig.AuthScheme.Value
 				}
 			}
 		}
+		if _, ok := channel.AdditionalProperties["config"]; ok {
+			channel.AdditionalProperties["config"] = config
+		}
 
-		channelJson, err := json.Marshal(hook.Channel)
+		channelJson, err := json.Marshal(channel)
 		if err != nil {
 			return diag.Errorf("error marshaling channel json: %v", err)
 		}
 		_ = d.Set("channel_json", string(channelJson))
 	} else {
 		err = utils.SetNonPrimitives(d, map[string]interface{}{
-			"channel": flattenInlineHookChannel(hook.Channel),
-			"headers": flattenInlineHookHeaders(hook.Channel),
-			"auth":    flattenInlineHookAuth(d, hook.Channel),
+			"channel":        flattenInlineHookChannel(channel, config),
+			"headers":        flattenInlineHookHeaders(config),
+			"auth":           flattenInlineHookAuth(d, config),
+			"channel_oauth2": flattenHookOAuth2(d, channel.GetType(), hookOAuth2FromProperties(config.AdditionalProperties)),
 		})
 	}
 	if err != nil {
@@ -169,13 +358,16 @@ func resourceInlineHookRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceInlineHookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := getOktaClientFromMetadata(meta)
-	hook := buildInlineHook(d)
-	newHook, _, err := client.InlineHook.UpdateInlineHook(ctx, d.Id(), hook)
+	client := getOktaV5ClientFromMetadata(meta)
+	hook, err := buildInlineHook(d)
 	if err != nil {
 		return diag.Errorf("failed to update inline hook: %v", err)
 	}
-	err = setInlineHookStatus(ctx, d, client, newHook.Status)
+	newHook, _, err := client.InlineHookAPI.ReplaceInlineHook(ctx, d.Id()).InlineHook(*hook).Execute()
+	if err != nil {
+		return diag.Errorf("failed to update inline hook: %v", err)
+	}
+	err = setInlineHookStatus(ctx, d, client, newHook.GetStatus())
 	if err != nil {
 		return diag.Errorf("failed to change inline hook's status: %v", err)
 	}
@@ -183,61 +375,68 @@ func resourceInlineHookUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceInlineHookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := getOktaClientFromMetadata(meta)
-	_, resp, err := client.InlineHook.DeactivateInlineHook(ctx, d.Id())
-	if err := utils.SuppressErrorOn404(resp, err); err != nil {
+	client := getOktaV5ClientFromMetadata(meta)
+	_, resp, err := client.InlineHookAPI.DeactivateInlineHook(ctx, d.Id()).Execute()
+	if err := utils.SuppressErrorOn404_V5(resp, err); err != nil {
 		return diag.Errorf("failed to deactivate inline hook: %v", err)
 	}
-	resp, err = client.InlineHook.DeleteInlineHook(ctx, d.Id())
-	if err := utils.SuppressErrorOn404(resp, err); err != nil {
+	resp, err = client.InlineHookAPI.DeleteInlineHook(ctx, d.Id()).Execute()
+	if err := utils.SuppressErrorOn404_V5(resp, err); err != nil {
 		return diag.Errorf("failed to delete inline hook: %v", err)
 	}
 	return nil
 }
 
-func buildInlineHook(d *schema.ResourceData) sdk.InlineHook {
-	inlineHook := sdk.InlineHook{
-		Name:    d.Get("name").(string),
-		Status:  d.Get("status").(string),
-		Type:    d.Get("type").(string),
-		Version: d.Get("version").(string),
+func buildInlineHook(d *schema.ResourceData) (*v5okta.InlineHook, error) {
+	inlineHook := &v5okta.InlineHook{
+		Name:    utils.StringPtr(d.Get("name").(string)),
+		Status:  utils.StringPtr(d.Get("status").(string)),
+		Type:    utils.StringPtr(d.Get("type").(string)),
+		Version: utils.StringPtr(d.Get("version").(string)),
 	}
 	if channelJson, ok := d.GetOk("channel_json"); ok {
-		var channel sdk.InlineHookChannel
+		var channel v5okta.InlineHookChannel
 		_ = json.Unmarshal([]byte(channelJson.(string)), &channel)
 		inlineHook.Channel = &channel
 	} else {
-		inlineHook.Channel = buildInlineChannel(d)
+		channel, err := buildInlineChannel(d)
+		if err != nil {
+			return nil, err
+		}
+		inlineHook.Channel = channel
 	}
-	return inlineHook
+	return inlineHook, nil
 }
 
-func buildInlineChannel(d *schema.ResourceData) *sdk.InlineHookChannel {
-	var headerList []*sdk.InlineHookChannelConfigHeaders
+func buildInlineChannel(d *schema.ResourceData) (*v5okta.InlineHookChannel, error) {
+	var headerList []v5okta.InlineHookChannelConfigHeaders
 	if raw, ok := d.GetOk("headers"); ok {
 		for _, header := range raw.(*schema.Set).List() {
 			h, ok := header.(map[string]interface{})
 			if ok {
-				headerList = append(headerList, &sdk.InlineHookChannelConfigHeaders{Key: h["key"].(string), Value: h["value"].(string)})
+				headerList = append(headerList, v5okta.InlineHookChannelConfigHeaders{
+					Key:   utils.StringPtr(h["key"].(string)),
+					Value: utils.StringPtr(h["value"].(string)),
+				})
 			}
 		}
 	}
-	var auth *sdk.InlineHookChannelConfigAuthScheme
+	var auth *v5okta.InlineHookChannelConfigAuthScheme
 	if rawAuth, ok := d.GetOk("auth"); ok {
 		a := rawAuth.(map[string]interface{})
 		_, ok := a["type"]
 		if !ok {
 			a["type"] = "HEADER"
 		}
-		auth = &sdk.InlineHookChannelConfigAuthScheme{}
-		if key, ok := a["key"]; ok && key != nil {
-			auth.Key = key.(string)
+		auth = &v5okta.InlineHookChannelConfigAuthScheme{}
+		if key, ok := a["key"]; ok && key != nil && key != "" {
+			auth.Key = utils.StringPtr(key.(string))
 		}
-		if _type, ok := a["type"]; ok && _type != nil {
-			auth.Type = _type.(string)
+		if _type, ok := a["type"]; ok && _type != nil && _type != "" {
+			auth.Type = utils.StringPtr(_type.(string))
 		}
-		if value, ok := a["value"]; ok && value != nil {
-			auth.Value = value.(string)
+		if value, ok := a["value"]; ok && value != nil && value != "" {
+			auth.Value = utils.StringPtr(value.(string))
 		}
 	}
 	rawChannel := d.Get("channel").(map[string]interface{})
@@ -245,28 +444,58 @@ func buildInlineChannel(d *schema.ResourceData) *sdk.InlineHookChannel {
 	if !ok {
 		rawChannel["method"] = "POST"
 	}
+	oauth2, err := buildHookOAuth2(d)
+	if err != nil {
+		return nil, err
+	}
 	_, ok = rawChannel["type"]
 	if !ok {
 		rawChannel["type"] = "HTTP"
+		if oauth2 != nil {
+			rawChannel["type"] = hookOAuth2ChannelType
+		}
 	}
-	return &sdk.InlineHookChannel{
-		Config: &sdk.InlineHookChannelConfig{
-			Uri:        rawChannel["uri"].(string),
-			AuthScheme: auth,
-			Headers:    headerList,
-			Method:     rawChannel["method"].(string),
-		},
-		Type:    rawChannel["type"].(string),
-		Version: rawChannel["version"].(string),
+	config := v5okta.InlineHookChannelConfig{
+		Uri:        utils.StringPtr(rawChannel["uri"].(string)),
+		AuthScheme: auth,
+		Headers:    headerList,
+		Method:     utils.StringPtr(rawChannel["method"].(string)),
+	}
+	if oauth2 != nil {
+		config.AdditionalProperties = hookOAuth2Properties(oauth2)
+	}
+	// the channel model has no config, the config depends on the channel type
+	channel := &v5okta.InlineHookChannel{
+		Type:                 utils.StringPtr(rawChannel["type"].(string)),
+		Version:              utils.StringPtr(rawChannel["version"].(string)),
+		AdditionalProperties: map[string]interface{}{"config": config},
+	}
+	return channel, nil
+}
+
+// inlineHookChannelConfig decodes the config of a channel, which the SDK keeps
+// in the additional properties of the channel. The OAuth 2.0 fields are in the
+// additional properties of the config.
+func inlineHookChannelConfig(c v5okta.InlineHookChannel) (v5okta.InlineHookChannelConfig, error) {
+	var config v5okta.InlineHookChannelConfig
+	if c.AdditionalProperties == nil || c.AdditionalProperties["config"] == nil {
+		config.AdditionalProperties = map[string]interface{}{}
+		return config, nil
+	}
+	b, err := json.Marshal(c.AdditionalProperties["config"])
+	if err != nil {
+		return config, err
 	}
+	err = json.Unmarshal(b, &config)
+	return config, err
 }
 
-func flattenInlineHookAuth(d *schema.ResourceData, c *sdk.InlineHookChannel) map[string]interface{} {
+func flattenInlineHookAuth(d *schema.ResourceData, c v5okta.InlineHookChannelConfig) map[string]interface{} {
 	auth := map[string]interface{}{}
-	if c.Config.AuthScheme != nil {
+	if c.AuthScheme != nil {
 		auth = map[string]interface{}{
-			"key":  c.Config.AuthScheme.Key,
-			"type": c.Config.AuthScheme.Type,
+			"key":  c.AuthScheme.GetKey(),
+			"type": c.AuthScheme.GetType(),
 			// Read only
 			"value": d.Get("auth").(map[string]interface{})["value"],
 		}
@@ -274,36 +503,36 @@ func flattenInlineHookAuth(d *schema.ResourceData, c *sdk.InlineHookChannel) map
 	return auth
 }
 
-func flattenInlineHookChannel(c *sdk.InlineHookChannel) map[string]interface{} {
+func flattenInlineHookChannel(c v5okta.InlineHookChannel, config v5okta.InlineHookChannelConfig) map[string]interface{} {
 	return map[string]interface{}{
-		"type":    c.Type,
-		"version": c.Version,
-		"uri":     c.Config.Uri,
-		"method":  c.Config.Method,
+		"type":    c.GetType(),
+		"version": c.GetVersion(),
+		"uri":     config.GetUri(),
+		"method":  config.GetMethod(),
 	}
 }
 
-func flattenInlineHookHeaders(c *sdk.InlineHookChannel) *schema.Set {
-	headers := make([]interface{}, len(c.Config.Headers))
-	for i, header := range c.Config.Headers {
+func flattenInlineHookHeaders(c v5okta.InlineHookChannelConfig) *schema.Set {
+	headers := make([]interface{}, len(c.Headers))
+	for i, header := range c.Headers {
 		headers[i] = map[string]interface{}{
-			"key":   header.Key,
-			"value": header.Value,
+			"key":   header.GetKey(),
+			"value": header.GetValue(),
 		}
 	}
 	return schema.NewSet(schema.HashResource(HeaderSchema), headers)
 }
 
-func setInlineHookStatus(ctx context.Context, d *schema.ResourceData, client *sdk.Client, status string) error {
+func setInlineHookStatus(ctx context.Context, d *schema.ResourceData, client *v5okta.APIClient, status string) error {
 	desiredStatus := d.Get("status").(string)
 	if status == desiredStatus {
 		return nil
 	}
 	var err error
 	if desiredStatus == StatusInactive {
-		_, _, err = client.InlineHook.DeactivateInlineHook(ctx, d.Id())
+		_, _, err = client.InlineHookAPI.DeactivateInlineHook(ctx, d.Id()).Execute()
 	} else {
-		_, _, err = client.InlineHook.ActivateInlineHook(ctx, d.Id())
+		_, _, err = client.InlineHookAPI.ActivateInlineHook(ctx, d.Id()).Execute()
 	}
 	return err
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -294,6 +295,70 @@ func TestAccResourceOktaInlineHook_token_channel_auth(t *testing.T) {
 	})
 }
 
+func TestAccResourceOktaInlineHook_channelOAuth2(t *testing.T) {
+	resourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSInlineHook)
+	mgr := newFixtureManager("resources", resources.OktaIDaaSInlineHook, t.Name())
+	config := mgr.GetFixtures("channel_oauth2.tf", t)
+	invalidConfig := mgr.GetFixtures("channel_oauth2_invalid.tf", t)
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		CheckDestroy:             checkResourceDestroy(resources.OktaIDaaSInlineHook, inlineHookExists),
+		Steps: []resource.TestStep{
+			{
+				Config:      invalidConfig,
+				ExpectError: regexp.MustCompile(`channel_oauth2.hook_key_id is required when auth_type is private_key_jwt`),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, inlineHookExists),
+					resource.TestCheckResourceAttr(resourceName, "channel.type", "OAUTH"),
+					resource.TestCheckResourceAttr(resourceName, "channel_oauth2.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "channel_oauth2.0.auth_type", "private_key_jwt"),
+					resource.TestCheckResourceAttr(resourceName, "channel_oauth2.0.client_id", "hook-client"),
+					resource.TestCheckResourceAttr(resourceName, "channel_oauth2.0.token_url", "https://example.com/oauth2/token"),
+					resource.TestCheckResourceAttr(resourceName, "channel_oauth2.0.scope", "hooks.write"),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_oauth2.0.hook_key_id", "okta_hook_key.test", "id"),
+					resource.TestCheckNoResourceAttr(resourceName, "auth"),
+					resource.TestCheckNoResourceAttr(resourceName, "channel_json"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateHookOAuth2(t *testing.T) {
+	tests := []struct {
+		name            string
+		authType        string
+		hookKeyID       string
+		hasClientSecret bool
+		wantErr         bool
+	}{
+		{"client secret", "client_secret_post", "", true, false},
+		{"client secret missing", "client_secret_post", "", false, true},
+		{"client secret with hook key", "client_secret_post", "key", true, true},
+		{"private key", "private_key_jwt", "key", false, false},
+		{"private key missing", "private_key_jwt", "", false, true},
+		{"private key with client secret", "private_key_jwt", "key", true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := idaas.ValidateHookOAuth2(tt.authType, tt.hookKeyID, tt.hasClientSecret)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateHookOAuth2() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func inlineHookExists(id string) (bool, error) {
 	client := iDaaSAPIClientForTestUtil.OktaSDKClientV2()
 	_, resp, err := client.InlineHook.GetInlineHook(context.Background(), id)
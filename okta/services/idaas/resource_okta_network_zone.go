@@ -2,10 +2,14 @@ package idaas
 
 import (
 	"context"
+	_ "embed"
 	"errors"
 	"fmt"
 	"net"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 
 	v6okta "github.com/okta/okta-sdk-golang/v6/okta"
 
@@ -17,6 +21,73 @@ import (
 
 const defaultEnhancedDynamicZone = "DefaultEnhancedDynamicZone"
 
+// networkZoneIPServiceCategories are the IP services a DYNAMIC_V2 zone can
+// include or exclude, the IPServiceCategory known values of the Okta API. Okta
+// adds categories over time, so the list is not authoritative.
+var networkZoneIPServiceCategories = []string{
+	"ABCPROXY_PROXY",
+	"ALL_ANONYMIZERS",
+	"ALL_ANONYMIZERS_EXCEPT_TOR",
+	"ALL_IP_SERVICES",
+	"ALL_PROXIES_VPNS",
+	"ANONYMIZER_TOR",
+	"APPLE_ICLOUD_RELAY_PROXY",
+	"ASTRILL_VPN",
+	"AVAST_VPN",
+	"BIGMAMA_PROXY",
+	"CYBERGHOST_VPN",
+	"DATAIMPULSE_PROXY",
+	"EXPRESS_VPN",
+	"FACELESS_PROXY",
+	"FROXY_PROXY",
+	"GEONODE_PROXY",
+	"GLOBALPROTECT_VPN",
+	"GOOGLE_VPN",
+	"IPCOLA_PROXY",
+	"IPIDEA_PROXY",
+	"IPROXYSHOP_PROXY",
+	"LUMINATI_PROXY",
+	"MULLVAD_VPN",
+	"NETNUT_PROXY",
+	"NEXUS_PROXY",
+	"NORD_VPN",
+	"OCULUS_PROXY",
+	"OXYLABS_PROXY",
+	"PIA_VPN",
+	"PROXYRACK_PROXY",
+	"SAMSUNG_VPN",
+	"SPACEPROXIES_PROXY",
+	"SURFSHARK_VPN",
+	"SYMANTEC_VPN",
+	"TRENDMICRO_VPN",
+	"ULTRASURF_VPN",
+	"WARP_VPN",
+	"YILU_PROXY",
+}
+
+// networkZoneDynamicV2Attributes can only be set on DYNAMIC_V2 zones
+var networkZoneDynamicV2Attributes = []string{
+	"dynamic_locations_exclude",
+	"ip_service_categories_include",
+	"ip_service_categories_exclude",
+}
+
+//go:embed iso3166.txt
+var iso3166Codes string
+
+// networkZoneLocations is the set of ISO 3166-1 country and ISO 3166-2
+// subdivision codes, parsed on first use.
+var networkZoneLocations = sync.OnceValue(func() map[string]bool {
+	locations := map[string]bool{}
+	for _, line := range strings.Split(iso3166Codes, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			locations[line] = true
+		}
+	}
+	return locations
+})
+
 func resourceNetworkZone() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceNetworkZoneCreate,
@@ -26,20 +97,38 @@ func resourceNetworkZone() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if !d.NewValueKnown("type") {
+				return nil
+			}
+			var set []string
+			for _, attr := range networkZoneDynamicV2Attributes {
+				if v, ok := d.GetOk(attr); ok && v.(*schema.Set).Len() > 0 {
+					set = append(set, attr)
+				}
+			}
+			return ValidateNetworkZoneAttributes(d.Get("type").(string), set)
+		},
 		Description: "Creates an Okta Network Zone. This resource allows you to create and configure an Okta Network Zone.",
 		Schema: map[string]*schema.Schema{
 			"dynamic_locations": {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Description: "Array of locations ISO-3166-1(2) included. Format code: countryCode OR countryCode-regionCode. Use with type `DYNAMIC` or `DYNAMIC_V2`",
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				MaxItems:    75,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateNetworkZoneLocation,
+				},
+				MaxItems: 75,
 			},
 			"dynamic_locations_exclude": {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Description: "Array of locations ISO-3166-1(2) excluded. Format code: countryCode OR countryCode-regionCode. Use with type `DYNAMIC_V2`",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateNetworkZoneLocation,
+				},
 			},
 			"dynamic_proxy_type": {
 				Type:        schema.TypeString,
@@ -93,19 +182,28 @@ func resourceNetworkZone() *schema.Resource {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Description: "List of asns included. Format of each array value: a string representation of an ASN numeric value. Use with type `DYNAMIC` or `DYNAMIC_V2`",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateASN,
+				},
 			},
 			"ip_service_categories_include": {
 				Type:        schema.TypeSet,
 				Optional:    true,
-				Description: "List of ip service included. Use with type `DYNAMIC_V2`",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of ip service included. Use with type `DYNAMIC_V2`. See the `okta_network_zone_ip_service_categories` data source for the known values, unknown values are reported as a warning.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateNetworkZoneIPServiceCategory,
+				},
 			},
 			"ip_service_categories_exclude": {
 				Type:        schema.TypeSet,
 				Optional:    true,
-				Description: "List of ip service excluded. Use with type `DYNAMIC_V2`",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of ip service excluded. Use with type `DYNAMIC_V2`. See the `okta_network_zone_ip_service_categories` data source for the known values, unknown values are reported as a warning.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateNetworkZoneIPServiceCategory,
+				},
 			},
 			"set_usage_as_exempt_list": {
 				Type:        schema.TypeBool,
@@ -317,20 +415,7 @@ func buildNetworkZone(d *schema.ResourceData) (v6okta.ListNetworkZones200Respons
 }
 
 func buildAddressObjList(values *schema.Set) []v6okta.NetworkZoneAddress {
-	var addressObjList []v6okta.NetworkZoneAddress
-	for _, value := range values.List() {
-		addr := value.(string)
-		obj := v6okta.NetworkZoneAddress{}
-		// Let API handle the type - if it contains "/" it's CIDR, otherwise RANGE
-		if strings.Contains(addr, "/") {
-			obj.SetType("CIDR")
-		} else {
-			obj.SetType("RANGE")
-		}
-		obj.SetValue(addr)
-		addressObjList = append(addressObjList, obj)
-	}
-	return addressObjList
+	return networkZoneAddresses(utils.ConvertInterfaceToStringSet(values))
 }
 
 func buildLocationList(values *schema.Set) []v6okta.NetworkZoneLocation {
@@ -515,3 +600,47 @@ func suppressIPDiff(k, old, new string, d *schema.ResourceData) bool {
 	}
 	return false
 }
+
+// validateASN checks that an ASN is a 32 bit autonomous system number.
+func validateASN(v interface{}, k string) (warnings []string, errors []error) {
+	asn := v.(string)
+	n, err := strconv.ParseUint(asn, 10, 32)
+	if err != nil || n == 0 {
+		errors = append(errors, fmt.Errorf("invalid ASN %q: must be a number between 1 and 4294967295", asn))
+	}
+	return
+}
+
+// validateNetworkZoneIPServiceCategory warns about IP service categories the
+// provider doesn't know, they may have been added to Okta after the release.
+func validateNetworkZoneIPServiceCategory(v interface{}, k string) (warnings []string, errors []error) {
+	category := v.(string)
+	if !slices.Contains(networkZoneIPServiceCategories, category) {
+		warnings = append(warnings, fmt.Sprintf("unknown IP service category %q, see the okta_network_zone_ip_service_categories data source for the known values", category))
+	}
+	return
+}
+
+// validateNetworkZoneLocation checks that a location is an ISO 3166-1 country
+// code or an ISO 3166-2 subdivision code.
+func validateNetworkZoneLocation(v interface{}, k string) (warnings []string, errors []error) {
+	location := v.(string)
+	if !networkZoneLocations()[location] {
+		errors = append(errors, fmt.Errorf("invalid location %q: must be an ISO 3166-1 country code such as \"US\" or an ISO 3166-2 subdivision code such as \"US-CA\"", location))
+	}
+	return
+}
+
+// ValidateNetworkZoneAttributes checks that the attributes only allowed on
+// DYNAMIC_V2 zones are not set on other zone types.
+func ValidateNetworkZoneAttributes(zoneType string, attrs []string) error {
+	if zoneType == "DYNAMIC_V2" {
+		return nil
+	}
+	for _, attr := range networkZoneDynamicV2Attributes {
+		if slices.Contains(attrs, attr) {
+			return fmt.Errorf("%s can only be set on network zones of type DYNAMIC_V2, not %s", attr, zoneType)
+		}
+	}
+	return nil
+}
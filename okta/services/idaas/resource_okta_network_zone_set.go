@@ -0,0 +1,619 @@
+package idaas
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v6okta "github.com/okta/okta-sdk-golang/v6/okta"
+	"github.com/okta/terraform-provider-okta/okta/config"
+	"github.com/okta/terraform-provider-okta/okta/utils"
+)
+
+// networkZoneSetMaxGateways is the number of gateways Okta accepts in a
+// single IP network zone
+const networkZoneSetMaxGateways = 150
+
+var (
+	_ resource.Resource                   = &networkZoneSetResource{}
+	_ resource.ResourceWithConfigure      = &networkZoneSetResource{}
+	_ resource.ResourceWithImportState    = &networkZoneSetResource{}
+	_ resource.ResourceWithModifyPlan     = &networkZoneSetResource{}
+	_ resource.ResourceWithValidateConfig = &networkZoneSetResource{}
+)
+
+type networkZoneSetResource struct {
+	*config.Config
+}
+
+type networkZoneSetModel struct {
+	ID                 types.String `tfsdk:"id"`
+	NamePrefix         types.String `tfsdk:"name_prefix"`
+	Gateways           types.Set    `tfsdk:"gateways"`
+	GatewaysFile       types.String `tfsdk:"gateways_file"`
+	Usage              types.String `tfsdk:"usage"`
+	Status             types.String `tfsdk:"status"`
+	MaxGatewaysPerZone types.Int64  `tfsdk:"max_gateways_per_zone"`
+	NormalizedGateways types.List   `tfsdk:"normalized_gateways"`
+	ZoneIDs            types.List   `tfsdk:"zone_ids"`
+}
+
+func newNetworkZoneSetResource() resource.Resource {
+	return &networkZoneSetResource{}
+}
+
+func (r *networkZoneSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_zone_set"
+}
+
+func (r *networkZoneSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *networkZoneSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name_prefix"), req.ID)...)
+}
+
+func (r *networkZoneSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a set of IP network zones holding a gateway list that is too large for a single zone. " +
+			"The CIDRs, ranges and addresses are normalized, deduplicated and adjacent or overlapping entries merged, " +
+			"then split across as many zones as needed, named `<name_prefix>-1`, `<name_prefix>-2` and so on.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name prefix of the zones.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				Required:    true,
+				Description: "Prefix of the zone names, the zones are named `<name_prefix>-<n>`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"gateways": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IP addresses, CIDRs (`10.0.0.0/8`) or ranges (`10.0.0.1-10.0.0.9`), IPv4 or IPv6.",
+			},
+			"gateways_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file with one IP address, CIDR or range per line, combined with `gateways`. Blank lines and `#` comments are ignored.",
+			},
+			"usage": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("POLICY"),
+				Description: "Usage of the zones - can be either `POLICY` or `BLOCKLIST`. By default, it is `POLICY`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("POLICY", "BLOCKLIST"),
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("ACTIVE"),
+				Description: "Status of the zones - can either be `ACTIVE` or `INACTIVE` only",
+				Validators: []validator.String{
+					stringvalidator.OneOf("ACTIVE", "INACTIVE"),
+				},
+			},
+			"max_gateways_per_zone": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(networkZoneSetMaxGateways),
+				Description: fmt.Sprintf("Maximum number of gateways per zone. Defaults to and may not exceed `%d`.", networkZoneSetMaxGateways),
+				Validators: []validator.Int64{
+					int64validator.Between(1, networkZoneSetMaxGateways),
+				},
+			},
+			"normalized_gateways": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The sorted, deduplicated and merged gateways, in the order they are split across the zones.",
+			},
+			"zone_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the zones, in name order. Reference all of them, e.g. in `network_includes` of a policy rule, as the number of zones grows with the gateway list.",
+			},
+		},
+	}
+}
+
+func (r *networkZoneSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data networkZoneSetModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.Gateways.IsNull() && data.GatewaysFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("gateways"), "Missing gateways", "At least one of gateways or gateways_file must be set.")
+	}
+}
+
+// ModifyPlan computes the normalized gateways at plan time, so invalid entries
+// fail the plan and changes to gateways_file show up as a diff.
+func (r *networkZoneSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan networkZoneSetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Gateways.IsUnknown() || plan.GatewaysFile.IsUnknown() || plan.MaxGatewaysPerZone.IsUnknown() {
+		return
+	}
+	gateways, diags := networkZoneSetGateways(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	normalized, err := NormalizeNetworkZoneGateways(gateways)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("gateways"), "Invalid gateway", err.Error())
+		return
+	}
+	plan.NormalizedGateways, diags = types.ListValueFrom(ctx, types.StringType, normalized)
+	resp.Diagnostics.Append(diags...)
+
+	// the zones are kept as long as their number doesn't change
+	plan.ZoneIDs = types.ListUnknown(types.StringType)
+	if !req.State.Raw.IsNull() {
+		var state networkZoneSetModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		shards := len(ShardNetworkZoneGateways(normalized, int(plan.MaxGatewaysPerZone.ValueInt64())))
+		if !state.ZoneIDs.IsNull() && len(state.ZoneIDs.Elements()) == shards {
+			plan.ZoneIDs = state.ZoneIDs
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *networkZoneSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan networkZoneSetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneIDs, err := r.applyZones(ctx, &plan, nil)
+	// keep track of the zones created so far, a failed create is tainted and
+	// removes them on the next apply
+	plan.ID = plan.NamePrefix
+	var diags diag.Diagnostics
+	plan.ZoneIDs, diags = types.ListValueFrom(ctx, types.StringType, zoneIDs)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating network zone set", "Could not create network zone set, unexpected error: "+err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *networkZoneSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state networkZoneSetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := r.OktaIDaaSClient.OktaSDKClientV6()
+
+	var zoneIDs []string
+	if state.ZoneIDs.IsNull() || state.ZoneIDs.IsUnknown() {
+		// imported, find the zones by name
+		ids, err := findNetworkZoneSetIDs(ctx, client, state.NamePrefix.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading network zone set", "Could not list network zones, unexpected error: "+err.Error())
+			return
+		}
+		if len(ids) == 0 {
+			resp.Diagnostics.AddError("Error reading network zone set", fmt.Sprintf("no network zones named %s-<n> found", state.NamePrefix.ValueString()))
+			return
+		}
+		zoneIDs = ids
+	} else {
+		resp.Diagnostics.Append(state.ZoneIDs.ElementsAs(ctx, &zoneIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var found []string
+	var gateways []string
+	for _, id := range zoneIDs {
+		zone, apiResp, err := client.NetworkZoneAPI.GetNetworkZone(ctx, id).Execute()
+		if err := utils.SuppressErrorOn404_V6(apiResp, err); err != nil {
+			resp.Diagnostics.AddError("Error reading network zone set", fmt.Sprintf("Could not read network zone %s, unexpected error: %s", id, err.Error()))
+			return
+		}
+		if zone == nil || zone.IPNetworkZone == nil {
+			// a deleted zone drops its gateways from the set, the next apply
+			// recreates it
+			continue
+		}
+		found = append(found, id)
+		for _, gateway := range zone.IPNetworkZone.GetGateways() {
+			gateways = append(gateways, gateway.GetValue())
+		}
+		state.Status = types.StringValue(zone.IPNetworkZone.GetStatus())
+		state.Usage = types.StringValue(zone.IPNetworkZone.GetUsage())
+	}
+	if len(found) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	normalized, err := NormalizeNetworkZoneGateways(gateways)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading network zone set", err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	state.ID = state.NamePrefix
+	state.ZoneIDs, diags = types.ListValueFrom(ctx, types.StringType, found)
+	resp.Diagnostics.Append(diags...)
+	state.NormalizedGateways, diags = types.ListValueFrom(ctx, types.StringType, normalized)
+	resp.Diagnostics.Append(diags...)
+	if state.MaxGatewaysPerZone.IsNull() {
+		state.MaxGatewaysPerZone = types.Int64Value(networkZoneSetMaxGateways)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *networkZoneSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state networkZoneSetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var existing []string
+	resp.Diagnostics.Append(state.ZoneIDs.ElementsAs(ctx, &existing, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	zoneIDs, err := r.applyZones(ctx, &plan, existing)
+	plan.ID = plan.NamePrefix
+	var diags diag.Diagnostics
+	plan.ZoneIDs, diags = types.ListValueFrom(ctx, types.StringType, zoneIDs)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating network zone set", "Could not update network zone set, unexpected error: "+err.Error())
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *networkZoneSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state networkZoneSetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var zoneIDs []string
+	resp.Diagnostics.Append(state.ZoneIDs.ElementsAs(ctx, &zoneIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, id := range zoneIDs {
+		if err := r.deleteZone(ctx, id); err != nil {
+			resp.Diagnostics.AddError("Error deleting network zone set", fmt.Sprintf("Could not delete network zone %s, unexpected error: %s", id, err.Error()))
+			return
+		}
+	}
+}
+
+// applyZones writes the shards of the planned gateways into the existing zones,
+// creating zones when there are more shards and deleting the zones left over.
+// It returns the IDs of the zones holding the shards, also on error.
+func (r *networkZoneSetResource) applyZones(ctx context.Context, plan *networkZoneSetModel, existing []string) ([]string, error) {
+	client := r.OktaIDaaSClient.OktaSDKClientV6()
+	var normalized []string
+	if diags := plan.NormalizedGateways.ElementsAs(ctx, &normalized, false); diags.HasError() {
+		return nil, fmt.Errorf("invalid normalized gateways")
+	}
+	shards := ShardNetworkZoneGateways(normalized, int(plan.MaxGatewaysPerZone.ValueInt64()))
+
+	var zoneIDs []string
+	for i, shard := range shards {
+		zone := v6okta.IPNetworkZone{}
+		zone.SetName(fmt.Sprintf("%s-%d", plan.NamePrefix.ValueString(), i+1))
+		zone.SetType("IP")
+		zone.SetUsage(plan.Usage.ValueString())
+		zone.SetStatus(plan.Status.ValueString())
+		zone.SetGateways(networkZoneAddresses(shard))
+		payload := v6okta.ListNetworkZones200ResponseInner{IPNetworkZone: &zone}
+
+		var id string
+		if i < len(existing) {
+			_, apiResp, err := client.NetworkZoneAPI.ReplaceNetworkZone(ctx, existing[i]).Zone(payload).Execute()
+			if err == nil {
+				id = existing[i]
+			} else if apiResp == nil || apiResp.StatusCode != http.StatusNotFound {
+				return zoneIDs, fmt.Errorf("failed to update network zone %s: %w", existing[i], err)
+			}
+		}
+		if id == "" {
+			created, _, err := client.NetworkZoneAPI.CreateNetworkZone(ctx).Zone(payload).Execute()
+			if err != nil {
+				return zoneIDs, fmt.Errorf("failed to create network zone %s: %w", zone.GetName(), err)
+			}
+			id, err = concreteNetworkZoneID(created)
+			if err != nil {
+				return zoneIDs, err
+			}
+		}
+		zoneIDs = append(zoneIDs, id)
+
+		var err error
+		if plan.Status.ValueString() == "ACTIVE" {
+			_, _, err = client.NetworkZoneAPI.ActivateNetworkZone(ctx, id).Execute()
+		} else {
+			_, _, err = client.NetworkZoneAPI.DeactivateNetworkZone(ctx, id).Execute()
+		}
+		if err != nil {
+			return zoneIDs, fmt.Errorf("failed to change status of network zone %s: %w", id, err)
+		}
+	}
+	for i := len(shards); i < len(existing); i++ {
+		if err := r.deleteZone(ctx, existing[i]); err != nil {
+			return zoneIDs, fmt.Errorf("failed to delete network zone %s: %w", existing[i], err)
+		}
+	}
+	return zoneIDs, nil
+}
+
+func (r *networkZoneSetResource) deleteZone(ctx context.Context, id string) error {
+	client := r.OktaIDaaSClient.OktaSDKClientV6()
+	_, resp, err := client.NetworkZoneAPI.DeactivateNetworkZone(ctx, id).Execute()
+	if err := utils.SuppressErrorOn404_V6(resp, err); err != nil {
+		return err
+	}
+	resp, err = client.NetworkZoneAPI.DeleteNetworkZone(ctx, id).Execute()
+	return utils.SuppressErrorOn404_V6(resp, err)
+}
+
+// findNetworkZoneSetIDs returns the IDs of the zones named <prefix>-<n>,
+// ordered by n.
+func findNetworkZoneSetIDs(ctx context.Context, client *v6okta.APIClient, prefix string) ([]string, error) {
+	zones, resp, err := client.NetworkZoneAPI.ListNetworkZones(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	for resp.HasNextPage() {
+		var moreZones []v6okta.ListNetworkZones200ResponseInner
+		resp, err = resp.Next(&moreZones)
+		if err != nil {
+			return nil, err
+		}
+		zones = append(zones, moreZones...)
+	}
+	pattern := regexp.MustCompile("^" + regexp.QuoteMeta(prefix) + `-(\d+)$`)
+	index := map[int]string{}
+	for _, zone := range zones {
+		if zone.IPNetworkZone == nil {
+			continue
+		}
+		match := pattern.FindStringSubmatch(zone.IPNetworkZone.GetName())
+		if match == nil {
+			continue
+		}
+		n, _ := strconv.Atoi(match[1])
+		index[n] = zone.IPNetworkZone.GetId()
+	}
+	numbers := make([]int, 0, len(index))
+	for n := range index {
+		numbers = append(numbers, n)
+	}
+	sort.Ints(numbers)
+	ids := make([]string, len(numbers))
+	for i, n := range numbers {
+		ids[i] = index[n]
+	}
+	return ids, nil
+}
+
+// networkZoneSetGateways returns the gateways of the configuration and the
+// gateways file.
+func networkZoneSetGateways(ctx context.Context, data *networkZoneSetModel) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var gateways []string
+	if !data.Gateways.IsNull() {
+		diags.Append(data.Gateways.ElementsAs(ctx, &gateways, false)...)
+	}
+	if file := data.GatewaysFile.ValueString(); file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			diags.AddAttributeError(path.Root("gateways_file"), "Error reading gateways file", err.Error())
+			return nil, diags
+		}
+		defer f.Close()
+		lines, err := ReadNetworkZoneGateways(f)
+		if err != nil {
+			diags.AddAttributeError(path.Root("gateways_file"), "Error reading gateways file", fmt.Sprintf("%s: %s", file, err.Error()))
+			return nil, diags
+		}
+		gateways = append(gateways, lines...)
+	}
+	return gateways, diags
+}
+
+// ReadNetworkZoneGateways reads a gateways file, one entry per line. Entries
+// may also be separated by commas or whitespace, blank lines and # comments
+// are ignored.
+func ReadNetworkZoneGateways(r io.Reader) ([]string, error) {
+	var gateways []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		gateways = append(gateways, strings.FieldsFunc(line, func(c rune) bool {
+			return c == ',' || c == ' ' || c == '\t' || c == '\r'
+		})...)
+	}
+	return gateways, scanner.Err()
+}
+
+type networkZoneGatewayRange struct {
+	start, end netip.Addr
+}
+
+// NormalizeNetworkZoneGateways parses IP addresses, CIDRs and ranges, merges
+// overlapping and adjacent ones and returns them sorted, IPv4 before IPv6.
+// Ranges that are exactly a CIDR block are returned in CIDR notation, single
+// addresses as /32 or /128 CIDRs.
+func NormalizeNetworkZoneGateways(gateways []string) ([]string, error) {
+	ranges := make([]networkZoneGatewayRange, 0, len(gateways))
+	for _, gateway := range gateways {
+		r, err := parseNetworkZoneGateway(strings.TrimSpace(gateway))
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	slices.SortFunc(ranges, func(a, b networkZoneGatewayRange) int {
+		if c := a.start.Compare(b.start); c != 0 {
+			return c
+		}
+		return a.end.Compare(b.end)
+	})
+
+	var merged []networkZoneGatewayRange
+	for _, r := range ranges {
+		if len(merged) > 0 {
+			last := &merged[len(merged)-1]
+			if last.start.Is4() == r.start.Is4() {
+				next := last.end.Next()
+				// an invalid next address means last ends at the top of the
+				// address space and covers r
+				if !next.IsValid() || r.start.Compare(next) <= 0 {
+					if r.end.Compare(last.end) > 0 {
+						last.end = r.end
+					}
+					continue
+				}
+			}
+		}
+		merged = append(merged, r)
+	}
+
+	normalized := make([]string, len(merged))
+	for i, r := range merged {
+		normalized[i] = formatNetworkZoneGateway(r)
+	}
+	return normalized, nil
+}
+
+// ShardNetworkZoneGateways splits the gateways into zones of at most size
+// gateways.
+func ShardNetworkZoneGateways(gateways []string, size int) [][]string {
+	var shards [][]string
+	for shard := range slices.Chunk(gateways, size) {
+		shards = append(shards, shard)
+	}
+	return shards
+}
+
+func parseNetworkZoneGateway(gateway string) (networkZoneGatewayRange, error) {
+	switch {
+	case strings.Contains(gateway, "/"):
+		prefix, err := netip.ParsePrefix(gateway)
+		if err != nil {
+			return networkZoneGatewayRange{}, fmt.Errorf("invalid CIDR format: %v", gateway)
+		}
+		if prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+			prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+		}
+		prefix = prefix.Masked()
+		return networkZoneGatewayRange{start: prefix.Addr(), end: lastNetworkZonePrefixAddr(prefix)}, nil
+	case strings.Contains(gateway, "-"):
+		parts := strings.Split(gateway, "-")
+		if len(parts) != 2 {
+			return networkZoneGatewayRange{}, fmt.Errorf("invalid IP range format: %v", gateway)
+		}
+		start, err1 := netip.ParseAddr(strings.TrimSpace(parts[0]))
+		end, err2 := netip.ParseAddr(strings.TrimSpace(parts[1]))
+		if err1 != nil || err2 != nil {
+			return networkZoneGatewayRange{}, fmt.Errorf("invalid IP range format: %v", gateway)
+		}
+		start, end = start.Unmap(), end.Unmap()
+		if start.Is4() != end.Is4() || start.Compare(end) > 0 {
+			return networkZoneGatewayRange{}, fmt.Errorf("invalid IP range format: %v", gateway)
+		}
+		return networkZoneGatewayRange{start: start, end: end}, nil
+	default:
+		addr, err := netip.ParseAddr(gateway)
+		if err != nil {
+			return networkZoneGatewayRange{}, fmt.Errorf("invalid IP address format: %v", gateway)
+		}
+		addr = addr.Unmap()
+		return networkZoneGatewayRange{start: addr, end: addr}, nil
+	}
+}
+
+func lastNetworkZonePrefixAddr(prefix netip.Prefix) netip.Addr {
+	b := prefix.Masked().Addr().AsSlice()
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 1 << (7 - i%8)
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+func formatNetworkZoneGateway(r networkZoneGatewayRange) string {
+	for bits := 0; bits <= r.start.BitLen(); bits++ {
+		prefix := netip.PrefixFrom(r.start, bits)
+		if prefix.Masked().Addr() == r.start && lastNetworkZonePrefixAddr(prefix) == r.end {
+			return prefix.String()
+		}
+	}
+	return r.start.String() + "-" + r.end.String()
+}
+
+func networkZoneAddresses(values []string) []v6okta.NetworkZoneAddress {
+	var addressObjList []v6okta.NetworkZoneAddress
+	for _, addr := range values {
+		obj := v6okta.NetworkZoneAddress{}
+		// Let API handle the type - if it contains "/" it's CIDR, otherwise RANGE
+		if strings.Contains(addr, "/") {
+			obj.SetType("CIDR")
+		} else {
+			obj.SetType("RANGE")
+		}
+		obj.SetValue(addr)
+		addressObjList = append(addressObjList, obj)
+	}
+	return addressObjList
+}
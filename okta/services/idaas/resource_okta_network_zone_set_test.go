@@ -0,0 +1,187 @@
+package idaas_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+	"github.com/okta/terraform-provider-okta/okta/services/idaas"
+)
+
+func TestAccResourceOktaNetworkZoneSet_crud(t *testing.T) {
+	mgr := newFixtureManager("resources", resources.OktaIDaaSNetworkZoneSet, t.Name())
+	config := mgr.GetFixtures("basic.tf", t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", t)
+	resourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSNetworkZoneSet)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		CheckDestroy:             checkNetworkZoneSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", acctest.BuildResourceName(mgr.Seed)),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "usage", "POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "normalized_gateways.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "normalized_gateways.0", "1.2.3.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "normalized_gateways.1", "2.3.4.5-2.3.4.15"),
+					resource.TestCheckResourceAttr(resourceName, "normalized_gateways.2", "3.3.3.3/32"),
+					resource.TestCheckResourceAttr(resourceName, "normalized_gateways.3", "4.4.4.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "zone_ids.#", "2"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "INACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "normalized_gateways.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "zone_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"gateways", "max_gateways_per_zone"},
+			},
+		},
+	})
+}
+
+func checkNetworkZoneSetDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resources.OktaIDaaSNetworkZoneSet {
+			continue
+		}
+		for key, id := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "zone_ids.") || key == "zone_ids.#" {
+				continue
+			}
+			exists, err := doesNetworkZoneExist(id)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return fmt.Errorf("network zone %s of %s still exists", id, rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func TestNormalizeNetworkZoneGateways(t *testing.T) {
+	tests := []struct {
+		name     string
+		gateways []string
+		expected []string
+		err      string
+	}{
+		{
+			name:     "single addresses",
+			gateways: []string{"10.0.0.1", "2001:db8::1"},
+			expected: []string{"10.0.0.1/32", "2001:db8::1/128"},
+		},
+		{
+			name:     "duplicates",
+			gateways: []string{"10.0.0.0/24", "10.0.0.0/24", "10.0.0.5"},
+			expected: []string{"10.0.0.0/24"},
+		},
+		{
+			name:     "adjacent CIDRs",
+			gateways: []string{"10.0.1.0/24", "10.0.0.0/24"},
+			expected: []string{"10.0.0.0/23"},
+		},
+		{
+			name:     "overlapping ranges",
+			gateways: []string{"10.0.0.1-10.0.0.9", "10.0.0.5-10.0.0.20", "10.0.0.21"},
+			expected: []string{"10.0.0.1-10.0.0.21"},
+		},
+		{
+			name:     "unmasked CIDR",
+			gateways: []string{"1.2.3.4/24"},
+			expected: []string{"1.2.3.0/24"},
+		},
+		{
+			name:     "range forming a CIDR",
+			gateways: []string{"192.168.0.0-192.168.0.255"},
+			expected: []string{"192.168.0.0/24"},
+		},
+		{
+			name:     "families are not merged",
+			gateways: []string{"::ffff:255.255.255.255", "2001:db8::/32", "0.0.0.0/0"},
+			expected: []string{"0.0.0.0/0", "2001:db8::/32"},
+		},
+		{
+			name:     "end of address space",
+			gateways: []string{"255.255.255.255", "255.255.255.254", "255.255.255.0/24"},
+			expected: []string{"255.255.255.0/24"},
+		},
+		{
+			name:     "invalid address",
+			gateways: []string{"10.0.0.256"},
+			err:      "invalid IP address format: 10.0.0.256",
+		},
+		{
+			name:     "invalid CIDR",
+			gateways: []string{"10.0.0.0/33"},
+			err:      "invalid CIDR format: 10.0.0.0/33",
+		},
+		{
+			name:     "reversed range",
+			gateways: []string{"10.0.0.9-10.0.0.1"},
+			err:      "invalid IP range format: 10.0.0.9-10.0.0.1",
+		},
+		{
+			name:     "mixed family range",
+			gateways: []string{"10.0.0.1-2001:db8::1"},
+			err:      "invalid IP range format: 10.0.0.1-2001:db8::1",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			normalized, err := idaas.NormalizeNetworkZoneGateways(tc.gateways)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("expected error %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(normalized, ",") != strings.Join(tc.expected, ",") {
+				t.Errorf("expected %v, got %v", tc.expected, normalized)
+			}
+		})
+	}
+}
+
+func TestShardNetworkZoneGateways(t *testing.T) {
+	gateways := []string{"1.1.1.1/32", "2.2.2.2/32", "3.3.3.3/32", "4.4.4.4/32", "5.5.5.5/32"}
+	shards := idaas.ShardNetworkZoneGateways(gateways, 2)
+	if len(shards) != 3 || len(shards[0]) != 2 || len(shards[2]) != 1 || shards[2][0] != "5.5.5.5/32" {
+		t.Errorf("unexpected shards %v", shards)
+	}
+	if shards := idaas.ShardNetworkZoneGateways(nil, 2); len(shards) != 0 {
+		t.Errorf("expected no shards, got %v", shards)
+	}
+}
+
+func TestReadNetworkZoneGateways(t *testing.T) {
+	file := "# partner egress\n1.2.3.4, 2.3.4.5-2.3.4.9\r\n\n  10.0.0.0/8 # office\n"
+	gateways, err := idaas.ReadNetworkZoneGateways(strings.NewReader(file))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(gateways, ",") != "1.2.3.4,2.3.4.5-2.3.4.9,10.0.0.0/8" {
+		t.Errorf("unexpected gateways %v", gateways)
+	}
+}
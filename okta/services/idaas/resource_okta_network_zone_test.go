@@ -3,6 +3,7 @@ package idaas_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/okta/terraform-provider-okta/okta/acctest"
 	"github.com/okta/terraform-provider-okta/okta/resources"
+	"github.com/okta/terraform-provider-okta/okta/services/idaas"
 	"github.com/okta/terraform-provider-okta/okta/utils"
 )
 
@@ -278,3 +280,54 @@ func doesNetworkZoneExist(id string) (bool, error) {
 	_, response, err := client.NetworkZone.GetNetworkZone(context.Background(), id)
 	return utils.DoesResourceExist(response, err)
 }
+
+func TestAccResourceOktaNetworkZone_validation(t *testing.T) {
+	mgr := newFixtureManager("resources", resources.OktaIDaaSNetworkZone, t.Name())
+	invalidDynamic := mgr.GetFixtures("invalid_dynamic.tf", t)
+	invalidDynamicV2Attributes := mgr.GetFixtures("invalid_dynamic_v2_attributes.tf", t)
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		CheckDestroy:             checkResourceDestroy(resources.OktaIDaaSNetworkZone, doesNetworkZoneExist),
+		Steps: []resource.TestStep{
+			{
+				Config:      invalidDynamic,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`invalid location "XX-NOPE"`),
+			},
+			{
+				Config:      invalidDynamic,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`invalid ASN "AS2232"`),
+			},
+			{
+				Config:      invalidDynamicV2Attributes,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`ip_service_categories_include can only be set on network zones of type DYNAMIC_V2`),
+			},
+		},
+	})
+}
+
+func TestValidateNetworkZoneAttributes(t *testing.T) {
+	tests := []struct {
+		zoneType string
+		attrs    []string
+		err      string
+	}{
+		{zoneType: "DYNAMIC_V2", attrs: []string{"dynamic_locations_exclude", "ip_service_categories_include", "ip_service_categories_exclude"}},
+		{zoneType: "DYNAMIC", attrs: nil},
+		{zoneType: "DYNAMIC", attrs: []string{"dynamic_locations_exclude"}, err: "dynamic_locations_exclude can only be set on network zones of type DYNAMIC_V2, not DYNAMIC"},
+		{zoneType: "IP", attrs: []string{"ip_service_categories_exclude"}, err: "ip_service_categories_exclude can only be set on network zones of type DYNAMIC_V2, not IP"},
+	}
+	for _, tc := range tests {
+		err := idaas.ValidateNetworkZoneAttributes(tc.zoneType, tc.attrs)
+		if tc.err == "" && err != nil {
+			t.Errorf("%s %v: unexpected error %v", tc.zoneType, tc.attrs, err)
+		}
+		if tc.err != "" && (err == nil || err.Error() != tc.err) {
+			t.Errorf("%s %v: expected error %q, got %v", tc.zoneType, tc.attrs, tc.err, err)
+		}
+	}
+}
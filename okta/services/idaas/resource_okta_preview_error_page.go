@@ -0,0 +1,140 @@
+package idaas
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &previewErrorPageResource{}
+	_ resource.ResourceWithConfigure   = &previewErrorPageResource{}
+	_ resource.ResourceWithImportState = &previewErrorPageResource{}
+)
+
+func newPreviewErrorPageResource() resource.Resource {
+	return &previewErrorPageResource{}
+}
+
+type previewErrorPageResource struct {
+	*config.Config
+}
+
+func (r *previewErrorPageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_preview_error_page"
+}
+
+func (r *previewErrorPageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	newSchema := resourceErrorPageSchema
+	newSchema.Description = "Manage the preview error page of a brand"
+	resp.Schema = newSchema
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *previewErrorPageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *previewErrorPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	var state errorPageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody, diags := buildErrorPageRequest(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previewErrorPage, _, err := r.OktaIDaaSClient.OktaSDKClientV3().CustomizationAPI.ReplacePreviewErrorPage(ctx, state.BrandID.ValueString()).ErrorPage(reqBody).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to update preview error page",
+			err.Error(),
+		)
+		return
+	}
+
+	mapErrorPageToState(previewErrorPage, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *previewErrorPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state errorPageModel
+
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previewErrorPage, _, err := r.OktaIDaaSClient.OktaSDKClientV3().CustomizationAPI.GetPreviewErrorPage(ctx, state.BrandID.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error retrieving preview error page",
+			fmt.Sprintf("Error returned: %s", err.Error()),
+		)
+		return
+	}
+
+	mapErrorPageToState(previewErrorPage, &state)
+
+	// Save data into state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *previewErrorPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	var state errorPageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.OktaIDaaSClient.OktaSDKClientV3().CustomizationAPI.DeletePreviewErrorPage(ctx, state.ID.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to delete preview error page",
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *previewErrorPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	var state errorPageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqBody, diags := buildErrorPageRequest(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previewErrorPage, _, err := r.OktaIDaaSClient.OktaSDKClientV3().CustomizationAPI.ReplacePreviewErrorPage(ctx, state.BrandID.ValueString()).ErrorPage(reqBody).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to update preview error page",
+			err.Error(),
+		)
+		return
+	}
+
+	mapErrorPageToState(previewErrorPage, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *previewErrorPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("brand_id"), req.ID)...)
+}
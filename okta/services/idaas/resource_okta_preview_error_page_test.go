@@ -0,0 +1,46 @@
+package idaas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+)
+
+func TestAccResourceOktaPreviewErrorPage_crud(t *testing.T) {
+	mgr := newFixtureManager("resources", resources.OktaIDaaSPreviewErrorPage, t.Name())
+	resourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSPreviewErrorPage)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		CheckDestroy:             nil,
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: mgr.GetFixtures("basic.tf", t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "brand_id", "okta_brand.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "page_content"),
+					resource.TestCheckResourceAttr(resourceName, "content_security_policy_setting.mode", "report_only"),
+					resource.TestCheckResourceAttr(resourceName, "content_security_policy_setting.src_list.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return rs.Primary.Attributes["brand_id"], nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,235 @@
+package idaas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v5okta "github.com/okta/okta-sdk-golang/v5/okta"
+	"github.com/okta/terraform-provider-okta/okta/config"
+)
+
+const realmUserMigrationMaxBatchSize = 200
+
+var (
+	_ resource.Resource              = &realmUserMigrationResource{}
+	_ resource.ResourceWithConfigure = &realmUserMigrationResource{}
+)
+
+type realmUserMigrationResource struct {
+	*config.Config
+}
+
+type realmUserMigrationModel struct {
+	ID                   types.String `tfsdk:"id"`
+	RealmID              types.String `tfsdk:"realm_id"`
+	Search               types.String `tfsdk:"search"`
+	BatchSize            types.Int64  `tfsdk:"batch_size"`
+	BatchIntervalSeconds types.Int64  `tfsdk:"batch_interval_seconds"`
+	Triggers             types.Map    `tfsdk:"triggers"`
+	MigratedUserIDs      types.List   `tfsdk:"migrated_user_ids"`
+	MigratedAt           types.String `tfsdk:"migrated_at"`
+}
+
+func newRealmUserMigrationResource() resource.Resource {
+	return &realmUserMigrationResource{}
+}
+
+func (r *realmUserMigrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_realm_user_migration"
+}
+
+func (r *realmUserMigrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.Config = resourceConfiguration(req, resp)
+}
+
+func (r *realmUserMigrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Moves the users matching a search into a realm. The users are moved in batches when the resource is created and every time it is replaced, which happens when `realm_id`, `search` or `triggers` change. " +
+			"After every batch the migration waits `batch_interval_seconds`, and until the rate limit resets when fewer requests than a batch are left. " +
+			"Users already in the realm are skipped, so a failed migration can be applied again. Destroying this resource leaves the users where they are.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the migration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"realm_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the realm to move the users to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"search": schema.StringAttribute{
+				Required:    true,
+				Description: "Search expression selecting the users to move, e.g. `profile.department eq \"Sales\"`. See the `search` argument of the `okta_users` data source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(20),
+				Description: fmt.Sprintf("Number of users moved per batch, between 1 and %d. Default: `20`.", realmUserMigrationMaxBatchSize),
+				Validators: []validator.Int64{
+					int64validator.Between(1, realmUserMigrationMaxBatchSize),
+				},
+			},
+			"batch_interval_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Description: "Seconds to wait between batches. Default: `1`.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 3600),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary map of values, the migration runs again whenever it changes.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"migrated_user_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the users moved by the migration.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"migrated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of the migration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *realmUserMigrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data realmUserMigrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.OktaIDaaSClient.OktaSDKClientV5()
+	realmID := data.RealmID.ValueString()
+	users, err := listRealmUsers(ctx, client, data.Search.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing users", "Could not list the users to migrate, unexpected error: "+err.Error())
+		return
+	}
+	var userIDs []string
+	for _, user := range users {
+		if user.GetRealmId() != realmID {
+			userIDs = append(userIDs, user.GetId())
+		}
+	}
+
+	migrated := []string{}
+	var failed []string
+	interval := time.Duration(data.BatchIntervalSeconds.ValueInt64()) * time.Second
+	batchSize := int(data.BatchSize.ValueInt64())
+	first := true
+	for batch := range slices.Chunk(userIDs, batchSize) {
+		if !first {
+			r.TimeOperations.Sleep(interval)
+		}
+		first = false
+		if err := ctx.Err(); err != nil {
+			resp.Diagnostics.AddError("Error migrating users", fmt.Sprintf("The migration was interrupted after moving %d users: %v", len(migrated), err))
+			return
+		}
+
+		var header http.Header
+		for _, userID := range batch {
+			_, apiResp, err := client.UserAPI.UpdateUser(ctx, userID).User(v5okta.UpdateUserRequest{RealmId: &realmID}).Execute()
+			if apiResp != nil {
+				header = apiResp.Header
+			}
+			if err != nil {
+				failed = append(failed, fmt.Sprintf("%s: %v", userID, err))
+				continue
+			}
+			migrated = append(migrated, userID)
+		}
+		if wait := RealmUserMigrationRateLimitWait(header, batchSize, time.Now()); wait > 0 {
+			r.TimeOperations.Sleep(wait)
+		}
+	}
+	if len(failed) > 0 {
+		resp.Diagnostics.AddError("Error migrating users",
+			fmt.Sprintf("Moved %d users to realm %s, could not move %d users:\n%s", len(migrated), realmID, len(failed), strings.Join(failed, "\n")))
+		return
+	}
+
+	migratedIDs, diags := types.ListValueFrom(ctx, types.StringType, migrated)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = types.StringValue(uuid.New().String())
+	data.MigratedUserIDs = migratedIDs
+	data.MigratedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *realmUserMigrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// the migration is a one-off, the users may legitimately move afterwards
+}
+
+func (r *realmUserMigrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// only the batch settings can change in place, they apply to the next run
+	var data realmUserMigrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *realmUserMigrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// the users stay in the realm they were moved to
+}
+
+// RealmUserMigrationRateLimitWait returns how long to wait before the next
+// batch, from the rate limit headers of the last response: until the limit
+// resets when fewer requests than a batch are left, no time otherwise.
+func RealmUserMigrationRateLimitWait(header http.Header, batchSize int, now time.Time) time.Duration {
+	remaining, err := strconv.Atoi(header.Get("X-Rate-Limit-Remaining"))
+	if err != nil || remaining >= batchSize {
+		return 0
+	}
+	reset, err := strconv.ParseInt(header.Get("X-Rate-Limit-Reset"), 10, 64)
+	if err != nil {
+		return 0
+	}
+	wait := time.Unix(reset, 0).Sub(now)
+	if wait < 0 {
+		return 0
+	}
+	// the reset time has a one second resolution
+	return wait + time.Second
+}
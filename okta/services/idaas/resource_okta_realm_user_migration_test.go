@@ -0,0 +1,66 @@
+package idaas_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/okta/acctest"
+	"github.com/okta/terraform-provider-okta/okta/resources"
+	"github.com/okta/terraform-provider-okta/okta/services/idaas"
+)
+
+func TestAccResourceOktaRealmUserMigration_crud(t *testing.T) {
+	mgr := newFixtureManager("resources", resources.OktaIDaaSRealmUserMigration, t.Name())
+	config := mgr.GetFixtures("basic.tf", t)
+	resourceName := fmt.Sprintf("%s.test", resources.OktaIDaaSRealmUserMigration)
+
+	acctest.OktaResourceTest(t, resource.TestCase{
+		PreCheck:                 acctest.AccPreCheck(t),
+		ErrorCheck:               testAccErrorChecks(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesForTestAcc(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "migrated_at"),
+					resource.TestCheckResourceAttr(resourceName, "migrated_user_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "migrated_user_ids.0", "okta_user.test", "id"),
+					resource.TestCheckResourceAttr("data.okta_users.test", "users.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestRealmUserMigrationRateLimitWait(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	header := func(remaining, reset string) http.Header {
+		h := http.Header{}
+		h.Set("X-Rate-Limit-Remaining", remaining)
+		h.Set("X-Rate-Limit-Reset", reset)
+		return h
+	}
+	tests := []struct {
+		name     string
+		header   http.Header
+		expected time.Duration
+	}{
+		{name: "no headers", header: nil, expected: 0},
+		{name: "enough left", header: header("100", "1700000030"), expected: 0},
+		{name: "exactly a batch left", header: header("20", "1700000030"), expected: 0},
+		{name: "too few left", header: header("5", "1700000030"), expected: 31 * time.Second},
+		{name: "reset passed", header: header("5", "1699999990"), expected: 0},
+		{name: "invalid reset", header: header("5", "soon"), expected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := idaas.RealmUserMigrationRateLimitWait(tt.header, 20, now); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}